@@ -17,6 +17,7 @@ variable "service_labels" {
     "apigatewayv2",
     "appconfig",
     "appflow",
+    "appintegrations",
     "applicationautoscaling",
     "applicationdiscoveryservice",
     "applicationinsights",
@@ -143,12 +144,14 @@ variable "service_labels" {
     "mediatailor",
     "memorydb",
     "meteringmarketplace",
+    "mgn",
     "mobile",
     "mq",
     "mwaa",
     "neptune",
     "networkfirewall",
     "networkmanager",
+    "opensearch",
     "opsworks",
     "opsworkscm",
     "organizations",
@@ -165,6 +168,7 @@ variable "service_labels" {
     "ram",
     "rds",
     "redshift",
+    "rekognition",
     "resourcegroups",
     "resourcegroupstaggingapi",
     "robomaker",
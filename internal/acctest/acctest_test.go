@@ -21,6 +21,37 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// TestProvider_tagsAll verifies that every resource with a configurable "tags"
+// argument also exposes "tags_all" and a CustomizeDiff so that provider-level
+// default_tags are applied.
+func TestProvider_tagsAll(t *testing.T) {
+	// Resources whose "tags" argument is not the resource's own tags.
+	skip := map[string]bool{
+		"aws_inspector_resource_group":       true, // Tags used to select EC2 instances.
+		"aws_secretsmanager_secret_rotation": true, // Unused, tags belong to aws_secretsmanager_secret.
+	}
+
+	for name, r := range provider.Provider().ResourcesMap {
+		if skip[name] {
+			continue
+		}
+
+		v, ok := r.Schema["tags"]
+
+		if !ok || v.Type != schema.TypeMap || (v.Computed && !v.Optional) {
+			continue
+		}
+
+		if _, ok := r.Schema["tags_all"]; !ok {
+			t.Errorf("%s: missing tags_all attribute", name)
+		}
+
+		if r.CustomizeDiff == nil {
+			t.Errorf("%s: missing CustomizeDiff (verify.SetTagsDiff)", name)
+		}
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = provider.Provider()
 }
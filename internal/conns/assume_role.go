@@ -0,0 +1,108 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// AssumeRole is the configuration of a single IAM Role assumption.
+type AssumeRole struct {
+	RoleARN           string
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	SessionName       string
+	SourceIdentity    string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// chainAssumeRoles assumes each role in turn using the credentials of the previous one
+// and returns a session using the credentials of the last role, along with its account ID and partition.
+// A non-empty stsEndpoint overrides the STS endpoint used for each assumption.
+func chainAssumeRoles(sess *session.Session, roles []*AssumeRole, stsEndpoint string) (*session.Session, string, string, error) {
+	for _, role := range roles {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, SourceIdentity: %q)",
+			role.RoleARN, role.SessionName, role.ExternalID, role.SourceIdentity)
+
+		creds := role.credentials(sess, stsEndpoint)
+
+		if _, err := creds.Get(); err != nil {
+			return nil, "", "", fmt.Errorf("error assuming IAM Role (%s): %w", role.RoleARN, err)
+		}
+
+		sess = sess.Copy(&aws.Config{Credentials: creds})
+	}
+
+	roleARN, err := arn.Parse(roles[len(roles)-1].RoleARN)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return sess, roleARN.AccountID, roleARN.Partition, nil
+}
+
+func (r *AssumeRole) credentials(sess *session.Session, stsEndpoint string) *credentials.Credentials {
+	config := &aws.Config{}
+
+	if stsEndpoint != "" {
+		config.Endpoint = aws.String(stsEndpoint)
+	}
+
+	conn := sts.New(sess, config)
+
+	// The SDK's AssumeRoleProvider does not support source identity.
+	if r.SourceIdentity != "" {
+		conn.Handlers.Validate.PushFront(func(req *request.Request) {
+			if input, ok := req.Params.(*sts.AssumeRoleInput); ok {
+				input.SourceIdentity = aws.String(r.SourceIdentity)
+			}
+		})
+	}
+
+	return stscreds.NewCredentialsWithClient(conn, r.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if r.DurationSeconds > 0 {
+			p.Duration = time.Duration(r.DurationSeconds) * time.Second
+		}
+
+		if r.ExternalID != "" {
+			p.ExternalID = aws.String(r.ExternalID)
+		}
+
+		if r.Policy != "" {
+			p.Policy = aws.String(r.Policy)
+		}
+
+		for _, policyARN := range r.PolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+
+		if r.SessionName != "" {
+			p.RoleSessionName = r.SessionName
+		}
+
+		for k, v := range r.Tags {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		if len(r.TransitiveTagKeys) > 0 {
+			p.TransitiveTagKeys = aws.StringSlice(r.TransitiveTagKeys)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestChainAssumeRoles(t *testing.T) {
+	const (
+		firstRoleARN  = "arn:aws:iam::111111111111:role/Hub"   //lintignore:AWSAT005
+		secondRoleARN = "arn:aws:iam::222222222222:role/Spoke" //lintignore:AWSAT005
+	)
+
+	stsEndpoints := []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+			"ExternalId":      "hub",
+			"RoleArn":         firstRoleARN,
+			"RoleSessionName": "first",
+		}),
+		awsbase.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+			"DurationSeconds": "3600",
+			"RoleArn":         secondRoleARN,
+			"RoleSessionName": "second",
+			"SourceIdentity":  "terraform",
+		}),
+	}
+
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", stsEndpoints)
+	defer closeFunc()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roles := []*AssumeRole{
+		{
+			ExternalID:  "hub",
+			RoleARN:     firstRoleARN,
+			SessionName: "first",
+		},
+		{
+			DurationSeconds: 3600,
+			RoleARN:         secondRoleARN,
+			SessionName:     "second",
+			SourceIdentity:  "terraform",
+		},
+	}
+
+	_, accountID, partition, err := chainAssumeRoles(sess, roles, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "222222222222"; accountID != expected {
+		t.Errorf("got account ID %s, expected %s", accountID, expected)
+	}
+
+	if expected := "aws"; partition != expected {
+		t.Errorf("got partition %s, expected %s", partition, expected)
+	}
+}
+
+func TestChainAssumeRoles_stsEndpoint(t *testing.T) {
+	const roleARN = "arn:aws:iam::222222222222:role/Spoke" //lintignore:AWSAT005
+
+	stsEndpoints := []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+			"RoleArn":         roleARN,
+			"RoleSessionName": "endpoint",
+		}),
+	}
+
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", stsEndpoints)
+	defer closeFunc()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the configured STS endpoint reaches the mock server.
+	stsEndpoint := aws.StringValue(sess.Config.Endpoint)
+	sess = sess.Copy(&aws.Config{Endpoint: aws.String("http://127.0.0.1:1")})
+
+	roles := []*AssumeRole{
+		{
+			RoleARN:     roleARN,
+			SessionName: "endpoint",
+		},
+	}
+
+	if _, _, _, err := chainAssumeRoles(sess, roles, stsEndpoint); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestChainAssumeRoles_error(t *testing.T) {
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", []*awsbase.MockEndpoint{})
+	defer closeFunc()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roles := []*AssumeRole{
+		{
+			RoleARN: "arn:aws:iam::111111111111:role/Hub", //lintignore:AWSAT005
+		},
+	}
+
+	if _, _, _, err := chainAssumeRoles(sess, roles, ""); err == nil {
+		t.Fatal("expected error")
+	}
+}
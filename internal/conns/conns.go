@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -193,6 +195,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -474,6 +477,7 @@ const (
 	NetworkFirewall               = "networkfirewall"
 	NetworkManager                = "networkmanager"
 	NimbleStudio                  = "nimblestudio"
+	OpenSearch                    = "opensearch"
 	OpsWorks                      = "opsworks"
 	OpsWorksCM                    = "opsworkscm"
 	Organizations                 = "organizations"
@@ -759,6 +763,7 @@ func init() {
 	serviceData[NetworkFirewall] = &ServiceDatum{AWSClientName: "NetworkFirewall", AWSServiceName: networkfirewall.ServiceName, AWSEndpointsID: networkfirewall.EndpointsID, AWSServiceID: networkfirewall.ServiceID, ProviderNameUpper: "NetworkFirewall", HCLKeys: []string{"networkfirewall"}}
 	serviceData[NetworkManager] = &ServiceDatum{AWSClientName: "NetworkManager", AWSServiceName: networkmanager.ServiceName, AWSEndpointsID: networkmanager.EndpointsID, AWSServiceID: networkmanager.ServiceID, ProviderNameUpper: "NetworkManager", HCLKeys: []string{"networkmanager"}}
 	serviceData[NimbleStudio] = &ServiceDatum{AWSClientName: "NimbleStudio", AWSServiceName: nimblestudio.ServiceName, AWSEndpointsID: nimblestudio.EndpointsID, AWSServiceID: nimblestudio.ServiceID, ProviderNameUpper: "NimbleStudio", HCLKeys: []string{"nimblestudio"}}
+	serviceData[OpenSearch] = &ServiceDatum{AWSClientName: "OpenSearchService", AWSServiceName: opensearch.ServiceName, AWSEndpointsID: opensearch.EndpointsID, AWSServiceID: opensearch.ServiceID, ProviderNameUpper: "OpenSearch", HCLKeys: []string{"opensearch", "opensearchservice"}}
 	serviceData[OpsWorks] = &ServiceDatum{AWSClientName: "OpsWorks", AWSServiceName: opsworks.ServiceName, AWSEndpointsID: opsworks.EndpointsID, AWSServiceID: opsworks.ServiceID, ProviderNameUpper: "OpsWorks", HCLKeys: []string{"opsworks"}}
 	serviceData[OpsWorksCM] = &ServiceDatum{AWSClientName: "OpsWorksCM", AWSServiceName: opsworkscm.ServiceName, AWSEndpointsID: opsworkscm.EndpointsID, AWSServiceID: opsworkscm.ServiceID, ProviderNameUpper: "OpsWorksCM", HCLKeys: []string{"opsworkscm"}}
 	serviceData[Organizations] = &ServiceDatum{AWSClientName: "Organizations", AWSServiceName: organizations.ServiceName, AWSEndpointsID: organizations.EndpointsID, AWSServiceID: organizations.ServiceID, ProviderNameUpper: "Organizations", HCLKeys: []string{"organizations"}}
@@ -854,14 +859,11 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN               string
-	AssumeRoleDurationSeconds   int
-	AssumeRoleExternalID        string
-	AssumeRolePolicy            string
-	AssumeRolePolicyARNs        []string
-	AssumeRoleSessionName       string
-	AssumeRoleTags              map[string]string
-	AssumeRoleTransitiveTagKeys []string
+	RetryMaxAttempts               int
+	RetryServiceMaxAttempts        map[string]int
+	RetryThrottleBackoffMultiplier float64
+
+	AssumeRole []*AssumeRole
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
@@ -1073,6 +1075,7 @@ type AWSClient struct {
 	NetworkFirewallConn               *networkfirewall.NetworkFirewall
 	NetworkManagerConn                *networkmanager.NetworkManager
 	NimbleStudioConn                  *nimblestudio.NimbleStudio
+	OpenSearchConn                    *opensearch.OpenSearchService
 	OpsWorksCMConn                    *opsworkscm.OpsWorksCM
 	OpsWorksConn                      *opsworks.OpsWorks
 	OrganizationsConn                 *organizations.Organizations
@@ -1189,33 +1192,48 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	maxRetries := c.MaxRetries
+	if c.RetryMaxAttempts > 0 {
+		maxRetries = c.RetryMaxAttempts - 1
+	}
+
 	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
-		AssumeRoleARN:               c.AssumeRoleARN,
-		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
-		AssumeRoleExternalID:        c.AssumeRoleExternalID,
-		AssumeRolePolicy:            c.AssumeRolePolicy,
-		AssumeRolePolicyARNs:        c.AssumeRolePolicyARNs,
-		AssumeRoleSessionName:       c.AssumeRoleSessionName,
-		AssumeRoleTags:              c.AssumeRoleTags,
-		AssumeRoleTransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
-		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
-		CallerName:                  "Terraform AWS Provider",
-		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints[IAM],
-		Insecure:                    c.Insecure,
-		HTTPProxy:                   c.HTTPProxy,
-		MaxRetries:                  c.MaxRetries,
-		Profile:                     c.Profile,
-		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
-		SkipCredsValidation:         c.SkipCredsValidation,
-		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
-		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints[STS],
-		Token:                       c.Token,
-		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
+		AccessKey:               c.AccessKey,
+		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
+		CallerName:              "Terraform AWS Provider",
+		CredsFilename:           c.CredsFilename,
+		DebugLogging:            logging.IsDebugOrHigher(),
+		IamEndpoint:             c.Endpoints[IAM],
+		Insecure:                c.Insecure,
+		HTTPProxy:               c.HTTPProxy,
+		MaxRetries:              maxRetries,
+		Profile:                 c.Profile,
+		Region:                  c.Region,
+		SecretKey:               c.SecretKey,
+		SkipCredsValidation:     c.SkipCredsValidation,
+		SkipMetadataApiCheck:    c.SkipMetadataApiCheck,
+		SkipRequestingAccountId: c.SkipRequestingAccountId,
+		StsEndpoint:             c.Endpoints[STS],
+		Token:                   c.Token,
+		UserAgentProducts:       StdUserAgentProducts(c.TerraformVersion),
+	}
+
+	// A single role without a source identity is assumed by aws-sdk-go-base.
+	// Role chains are assumed hop by hop once the base session is established.
+	var assumeRoleChain []*AssumeRole
+
+	if len(c.AssumeRole) == 1 && c.AssumeRole[0].SourceIdentity == "" {
+		assumeRole := c.AssumeRole[0]
+		awsbaseConfig.AssumeRoleARN = assumeRole.RoleARN
+		awsbaseConfig.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+		awsbaseConfig.AssumeRoleExternalID = assumeRole.ExternalID
+		awsbaseConfig.AssumeRolePolicy = assumeRole.Policy
+		awsbaseConfig.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+		awsbaseConfig.AssumeRoleSessionName = assumeRole.SessionName
+		awsbaseConfig.AssumeRoleTags = assumeRole.Tags
+		awsbaseConfig.AssumeRoleTransitiveTagKeys = assumeRole.TransitiveTagKeys
+	} else {
+		assumeRoleChain = c.AssumeRole
 	}
 
 	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
@@ -1223,6 +1241,13 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if len(assumeRoleChain) > 0 {
+		sess, accountID, Partition, err = chainAssumeRoles(sess, assumeRoleChain, c.Endpoints[STS])
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -1231,6 +1256,11 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	// Must be added before any service clients are created from the session.
+	if handler := c.retryerHandler(sessionMaxRetries(sess)); handler != nil {
+		sess.Handlers.Validate.PushBack(handler)
+	}
+
 	DNSSuffix := "amazonaws.com"
 	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
 		DNSSuffix = p.DNSSuffix()
@@ -1425,6 +1455,7 @@ func (c *Config) Client() (interface{}, error) {
 		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
 		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
 		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
+		OpenSearchConn:                    opensearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpenSearch])})),
 		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
 		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
 		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
@@ -1454,7 +1485,6 @@ func (c *Config) Client() (interface{}, error) {
 		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
 		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
 		RoboMakerConn:                     robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RoboMaker])})),
-		Route53DomainsConn:                route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Domains])})),
 		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig])})),
 		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness])})),
 		Route53ResolverConn:               route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Resolver])})),
@@ -1519,6 +1549,9 @@ func (c *Config) Client() (interface{}, error) {
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[Route53]),
 	}
+	route53DomainsConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[Route53Domains]),
+	}
 	route53RecoveryControlConfigConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig]),
 	}
@@ -1545,6 +1578,7 @@ func (c *Config) Client() (interface{}, error) {
 	case endpoints.AwsPartitionID:
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
+		route53DomainsConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
@@ -1561,6 +1595,7 @@ func (c *Config) Client() (interface{}, error) {
 
 	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
 	client.Route53Conn = route53.New(sess.Copy(route53Config))
+	client.Route53DomainsConn = route53domains.New(sess.Copy(route53DomainsConfig))
 	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
 	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
 	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
@@ -1889,6 +1924,62 @@ func ReverseDNS(hostname string) string {
 // This is a global MutexKV for use within this plugin.
 var GlobalMutexKV = NewMutexKV()
 
+// sessionMaxRetries returns the maximum number of retries of the retryer that
+// the SDK gives to service clients created from the specified session.
+func sessionMaxRetries(sess *session.Session) int {
+	if v := sess.Config.MaxRetries; v != nil && aws.IntValue(v) != aws.UseServiceDefaultRetries {
+		return aws.IntValue(v)
+	}
+
+	return client.DefaultRetryerMaxNumRetries
+}
+
+// retryerHandler returns a request handler that applies the provider retry
+// configuration, or nil if there is nothing to override.
+// The handler replaces each request's retryer so that per-service maximum
+// attempts and the throttling backoff multiplier are honored by all clients.
+// Only the SDK default retryer, as identified by defaultMaxRetries, is replaced;
+// retryers customized for an individual service client are left untouched.
+func (c *Config) retryerHandler(defaultMaxRetries int) func(*request.Request) {
+	multiplier := c.RetryThrottleBackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	if multiplier == 1 && len(c.RetryServiceMaxAttempts) == 0 {
+		return nil
+	}
+
+	serviceMaxRetries := make(map[string]int)
+	for k, v := range c.RetryServiceMaxAttempts {
+		if d, ok := serviceData[k]; ok {
+			serviceMaxRetries[d.AWSServiceID] = v - 1
+		}
+	}
+
+	minThrottleDelay := time.Duration(float64(client.DefaultRetryerMinThrottleDelay) * multiplier)
+	maxThrottleDelay := time.Duration(float64(client.DefaultRetryerMaxThrottleDelay) * multiplier)
+
+	defaultRetryer := client.DefaultRetryer{NumMaxRetries: defaultMaxRetries}
+
+	return func(r *request.Request) {
+		if v, ok := r.Retryer.(client.DefaultRetryer); !ok || v != defaultRetryer {
+			return
+		}
+
+		maxRetries := r.MaxRetries()
+		if v, ok := serviceMaxRetries[r.ClientInfo.ServiceID]; ok {
+			maxRetries = v
+		}
+
+		r.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinThrottleDelay: minThrottleDelay,
+			MaxThrottleDelay: maxThrottleDelay,
+		}
+	}
+}
+
 func ServiceForHCLKey(s string) (string, error) {
 	for k, v := range serviceData {
 		for _, hclKey := range v.HCLKeys {
@@ -3,8 +3,14 @@ package conns
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/s3"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
 
@@ -82,6 +88,107 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestConfigRetryerHandler(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		Config                   *Config
+		ServiceID                string
+		Retryer                  request.Retryer
+		ExpectNil                bool
+		ExpectedMaxRetries       int
+		ExpectedMinThrottleDelay time.Duration
+	}{
+		{
+			Name:      "no overrides",
+			Config:    &Config{},
+			ExpectNil: true,
+		},
+		{
+			Name: "throttle backoff multiplier",
+			Config: &Config{
+				RetryThrottleBackoffMultiplier: 2,
+			},
+			ServiceID:                ec2.ServiceID,
+			ExpectedMaxRetries:       25,
+			ExpectedMinThrottleDelay: 2 * client.DefaultRetryerMinThrottleDelay,
+		},
+		{
+			Name: "service override",
+			Config: &Config{
+				RetryServiceMaxAttempts: map[string]int{EC2: 5},
+			},
+			ServiceID:                ec2.ServiceID,
+			ExpectedMaxRetries:       4,
+			ExpectedMinThrottleDelay: client.DefaultRetryerMinThrottleDelay,
+		},
+		{
+			Name: "service override other service",
+			Config: &Config{
+				RetryServiceMaxAttempts: map[string]int{EC2: 5},
+			},
+			ServiceID:                s3.ServiceID,
+			ExpectedMaxRetries:       25,
+			ExpectedMinThrottleDelay: client.DefaultRetryerMinThrottleDelay,
+		},
+		{
+			Name: "per-client retryer preserved",
+			Config: &Config{
+				RetryServiceMaxAttempts:        map[string]int{EC2: 5},
+				RetryThrottleBackoffMultiplier: 2,
+			},
+			ServiceID:                ec2.ServiceID,
+			Retryer:                  client.DefaultRetryer{NumMaxRetries: 8},
+			ExpectedMaxRetries:       8,
+			ExpectedMinThrottleDelay: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			handler := testCase.Config.retryerHandler(25)
+
+			if testCase.ExpectNil {
+				if handler != nil {
+					t.Fatal("expected nil handler")
+				}
+
+				return
+			}
+
+			retryer := testCase.Retryer
+			if retryer == nil {
+				retryer = client.DefaultRetryer{NumMaxRetries: 25}
+			}
+
+			r := request.New(
+				aws.Config{},
+				metadata.ClientInfo{ServiceID: testCase.ServiceID},
+				request.Handlers{},
+				retryer,
+				&request.Operation{Name: "Test"},
+				nil,
+				nil,
+			)
+
+			handler(r)
+
+			result, ok := r.Retryer.(client.DefaultRetryer)
+
+			if !ok {
+				t.Fatalf("unexpected retryer type: %T", r.Retryer)
+			}
+
+			if got, expected := result.MaxRetries(), testCase.ExpectedMaxRetries; got != expected {
+				t.Errorf("got max retries %d, expected %d", got, expected)
+			}
+
+			if got, expected := result.MinThrottleDelay, testCase.ExpectedMinThrottleDelay; got != expected {
+				t.Errorf("got min throttle delay %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
@@ -21,12 +21,16 @@ import (
 
 const (
 	filename = "list_pages_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
 )
 
 var (
-	listOps   = flag.String("ListOps", "", "ListOps")
-	paginator = flag.String("Paginator", "NextToken", "name of the pagination token field")
-	export    = flag.Bool("Export", false, "whether to export the list functions")
+	listOps    = flag.String("ListOps", "", "ListOps")
+	paginator  = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	export     = flag.Bool("Export", false, "whether to export the list functions")
+	sdkVersion = flag.Int("AWSSDKVersion", 1, "Version of the AWS Go SDK to use i.e. 1 or 2")
 )
 
 func usage() {
@@ -72,17 +76,33 @@ func main() {
 	functions := strings.Split(templateData.ListOps, ",")
 	sort.Strings(functions)
 
+	var sourcePackage, awsPackage, functionTmpl string
+
+	switch *sdkVersion {
+	case sdkV1:
+		sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", templateData.AWSService)
+		awsPackage = "github.com/aws/aws-sdk-go/aws"
+		functionTmpl = functionTemplate
+	case sdkV2:
+		sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", templateData.AWSService)
+		awsPackage = "github.com/aws/aws-sdk-go-v2/aws"
+		functionTmpl = functionTemplateV2
+	default:
+		log.Fatalf("AWSSDKVersion must be either %d or %d", sdkV1, sdkV2)
+	}
+
 	g := Generator{
-		paginator: templateData.Paginator,
-		tmpl:      template.Must(template.New("function").Parse(functionTemplate)),
+		paginator:  templateData.Paginator,
+		sdkVersion: *sdkVersion,
+		tmpl:       template.Must(template.New("function").Parse(functionTmpl)),
 	}
 
-	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", templateData.AWSService)
 	g.parsePackage(sourcePackage)
 
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: templateData.ServicePackage,
+		AWSPackage:         awsPackage,
 		SourcePackage:      sourcePackage,
 	})
 
@@ -101,14 +121,16 @@ func main() {
 type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
+	AWSPackage         string
 	SourcePackage      string
 }
 
 type Generator struct {
-	buf       bytes.Buffer
-	pkg       *Package
-	tmpl      *template.Template
-	paginator string
+	buf        bytes.Buffer
+	pkg        *Package
+	tmpl       *template.Template
+	paginator  string
+	sdkVersion int
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -198,12 +220,19 @@ func (g *Generator) generateFunction(functionName string, export bool) {
 		funcName = fmt.Sprintf("%s%s", strings.ToLower(funcName[0:1]), funcName[1:])
 	}
 
+	// AWS SDK for Go v1 operations take a single input parameter.
+	// AWS SDK for Go v2 operations take a context.Context followed by the input parameter.
+	paramIndex := 0
+	if g.sdkVersion == sdkV2 {
+		paramIndex = 1
+	}
+
 	funcSpec := FuncSpec{
 		Name:       fixSomeInitialisms(funcName),
 		AWSName:    function.Name.Name,
-		RecvType:   g.expandTypeField(function.Recv),
-		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
-		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
+		RecvType:   g.expandTypeField(function.Recv, 0),
+		ParamType:  g.expandTypeField(function.Type.Params, paramIndex),
+		ResultType: g.expandTypeField(function.Type.Results, 0), // Assumes we can take the first return parameter
 		Paginator:  g.paginator,
 	}
 
@@ -213,8 +242,25 @@ func (g *Generator) generateFunction(functionName string, export bool) {
 	}
 }
 
-func (g *Generator) expandTypeField(field *ast.FieldList) string {
-	typeValue := field.List[0].Type
+func (g *Generator) expandTypeField(field *ast.FieldList, index int) string {
+	var types []ast.Expr
+
+	// A field may declare several names sharing a single type, e.g. (a, b *T).
+	for _, f := range field.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, f.Type)
+		}
+	}
+
+	if index >= len(types) {
+		log.Fatalf("Unexpected field list: no field at index %d", index)
+	}
+
+	typeValue := types[index]
 	if star, ok := typeValue.(*ast.StarExpr); ok {
 		return fmt.Sprintf("*%s", g.expandTypeExpr(star.X))
 	}
@@ -239,7 +285,7 @@ package {{ .DestinationPackage }}
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"{{ .AWSPackage }}"
 	"{{ .SourcePackage }}"
 )
 `
@@ -268,6 +314,26 @@ func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, inpu
 }
 `
 
+const functionTemplateV2 = `
+
+func {{ .Name }}Pages(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .ResultType }}, bool) bool) error {
+	for {
+		output, err := conn.{{ .AWSName }}(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.{{ .Paginator }}) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.{{ .Paginator }} = output.{{ .Paginator }}
+	}
+	return nil
+}
+`
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
@@ -346,7 +412,7 @@ func awsServiceNameUpper(s string) (string, error) {
 	return "", fmt.Errorf("unable to find AWS service name for %s", s)
 }
 
-//awsServiceNames provides correct names and capitalization as used by AWS in client var
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
 var awsServiceNames map[string]string
 
 func init() {
@@ -363,7 +429,7 @@ func init() {
 	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
 	awsServiceNames["appflow"] = "AppFlow"
-	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["appintegrationsservice"] = "AppIntegrationsService"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
 	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
@@ -618,8 +684,8 @@ func init() {
 	awsServiceNames["textract"] = "Textract"
 	awsServiceNames["timestreamquery"] = "TimestreamQuery"
 	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
-	awsServiceNames["transcribe"] = "Transcribe"
-	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
+	awsServiceNames["transcribeservice"] = "TranscribeService"
+	awsServiceNames["transcribestreamingservice"] = "TranscribeStreamingService"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
 	awsServiceNames["waf"] = "WAF"
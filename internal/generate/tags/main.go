@@ -870,7 +870,7 @@ func init() {
 	awsServiceNames["apigatewayv2"] = "ApiGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
 	awsServiceNames["appflow"] = "AppFlow"
-	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["appintegrationsservice"] = "AppIntegrationsService"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
 	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
@@ -1125,8 +1125,8 @@ func init() {
 	awsServiceNames["textract"] = "Textract"
 	awsServiceNames["timestreamquery"] = "TimestreamQuery"
 	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
-	awsServiceNames["transcribe"] = "Transcribe"
-	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
+	awsServiceNames["transcribeservice"] = "TranscribeService"
+	awsServiceNames["transcribestreamingservice"] = "TranscribeStreamingService"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
 	awsServiceNames["waf"] = "WAF"
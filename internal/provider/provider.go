@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
@@ -74,6 +76,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
@@ -86,6 +89,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
@@ -102,10 +106,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -116,9 +122,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -147,6 +155,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
@@ -221,6 +230,8 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry": retrySchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -283,6 +294,25 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"no_refresh_services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								// Services whose resources honor tftags.IgnoreConfig.SkipRefresh.
+								ValidateFunc: validation.StringInSlice([]string{
+									"elasticache",
+								}, false),
+							},
+							Set:         schema.HashString,
+							Description: "Services for which resource tags are not read during refresh.",
+						},
+						"refresh_tags": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to read resource tags during refresh.",
+						},
 					},
 				},
 			},
@@ -338,6 +368,8 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_accessanalyzer_generated_policy": accessanalyzer.DataSourceGeneratedPolicy(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -349,8 +381,9 @@ func Provider() *schema.Provider {
 			"aws_api_gateway_rest_api":    apigateway.DataSourceRestAPI(),
 			"aws_api_gateway_vpc_link":    apigateway.DataSourceVPCLink(),
 
-			"aws_apigatewayv2_api":  apigatewayv2.DataSourceAPI(),
-			"aws_apigatewayv2_apis": apigatewayv2.DataSourceAPIs(),
+			"aws_apigatewayv2_api":    apigatewayv2.DataSourceAPI(),
+			"aws_apigatewayv2_apis":   apigatewayv2.DataSourceAPIs(),
+			"aws_apigatewayv2_export": apigatewayv2.DataSourceExport(),
 
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
@@ -368,9 +401,10 @@ func Provider() *schema.Provider {
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
 
-			"aws_cloudformation_export": cloudformation.DataSourceExport(),
-			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
-			"aws_cloudformation_type":   cloudformation.DataSourceType(),
+			"aws_cloudformation_export":  cloudformation.DataSourceExport(),
+			"aws_cloudformation_exports": cloudformation.DataSourceExports(),
+			"aws_cloudformation_stack":   cloudformation.DataSourceStack(),
+			"aws_cloudformation_type":    cloudformation.DataSourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.DataSourceCachePolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.DataSourceDistribution(),
@@ -414,7 +448,8 @@ func Provider() *schema.Provider {
 
 			"aws_directory_service_directory": ds.DataSourceDirectory(),
 
-			"aws_dynamodb_table": dynamodb.DataSourceTable(),
+			"aws_dynamodb_table":         dynamodb.DataSourceTable(),
+			"aws_dynamodb_table_exports": dynamodb.DataSourceTableExports(),
 
 			"aws_ami":                                        ec2.DataSourceAMI(),
 			"aws_ami_ids":                                    ec2.DataSourceAMIIDs(),
@@ -457,6 +492,7 @@ func Provider() *schema.Provider {
 			"aws_key_pair":                                   ec2.DataSourceKeyPair(),
 			"aws_launch_template":                            ec2.DataSourceLaunchTemplate(),
 			"aws_nat_gateway":                                ec2.DataSourceNatGateway(),
+			"aws_network_acl":                                ec2.DataSourceNetworkACL(),
 			"aws_network_acls":                               ec2.DataSourceNetworkACLs(),
 			"aws_network_interface":                          ec2.DataSourceNetworkInterface(),
 			"aws_network_interfaces":                         ec2.DataSourceNetworkInterfaces(),
@@ -487,18 +523,20 @@ func Provider() *schema.Provider {
 			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
 			"aws_ecs_service":              ecs.DataSourceService(),
 			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_task_execution":       ecs.DataSourceTaskExecution(),
 
 			"aws_efs_access_point":  efs.DataSourceAccessPoint(),
 			"aws_efs_access_points": efs.DataSourceAccessPoints(),
 			"aws_efs_file_system":   efs.DataSourceFileSystem(),
 			"aws_efs_mount_target":  efs.DataSourceMountTarget(),
 
-			"aws_eks_addon":        eks.DataSourceAddon(),
-			"aws_eks_cluster":      eks.DataSourceCluster(),
-			"aws_eks_clusters":     eks.DataSourceClusters(),
-			"aws_eks_cluster_auth": eks.DataSourceClusterAuth(),
-			"aws_eks_node_group":   eks.DataSourceNodeGroup(),
-			"aws_eks_node_groups":  eks.DataSourceNodeGroups(),
+			"aws_eks_addon":         eks.DataSourceAddon(),
+			"aws_eks_addon_version": eks.DataSourceAddonVersion(),
+			"aws_eks_cluster":       eks.DataSourceCluster(),
+			"aws_eks_clusters":      eks.DataSourceClusters(),
+			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
+			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
+			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
 
 			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
 			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
@@ -584,6 +622,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_functions":           lambda.DataSourceFunctions(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -641,7 +680,9 @@ func Provider() *schema.Provider {
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
 			"aws_route53_delegation_set": route53.DataSourceDelegationSet(),
+			"aws_route53_records":        route53.DataSourceRecords(),
 			"aws_route53_zone":           route53.DataSourceZone(),
+			"aws_route53_zones":          route53.DataSourceZones(),
 
 			"aws_route53_resolver_endpoint": route53resolver.DataSourceEndpoint(),
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
@@ -714,10 +755,13 @@ func Provider() *schema.Provider {
 			"aws_workspaces_directory": workspaces.DataSourceDirectory(),
 			"aws_workspaces_image":     workspaces.DataSourceImage(),
 			"aws_workspaces_workspace": workspaces.DataSourceWorkspace(),
+
+			"aws_xray_sampling_rules": xray.DataSourceSamplingRules(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_analyzer":     accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
 
@@ -773,6 +817,7 @@ func Provider() *schema.Provider {
 			"aws_apigatewayv2_model":                apigatewayv2.ResourceModel(),
 			"aws_apigatewayv2_route":                apigatewayv2.ResourceRoute(),
 			"aws_apigatewayv2_route_response":       apigatewayv2.ResourceRouteResponse(),
+			"aws_apigatewayv2_route_settings":       apigatewayv2.ResourceRouteSettings(),
 			"aws_apigatewayv2_stage":                apigatewayv2.ResourceStage(),
 			"aws_apigatewayv2_vpc_link":             apigatewayv2.ResourceVPCLink(),
 
@@ -787,6 +832,9 @@ func Provider() *schema.Provider {
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
 			"aws_appautoscaling_target":           appautoscaling.ResourceTarget(),
 
+			"aws_appintegrations_data_integration":  appintegrations.ResourceDataIntegration(),
+			"aws_appintegrations_event_integration": appintegrations.ResourceEventIntegration(),
+
 			"aws_appmesh_gateway_route":   appmesh.ResourceGatewayRoute(),
 			"aws_appmesh_mesh":            appmesh.ResourceMesh(),
 			"aws_appmesh_route":           appmesh.ResourceRoute(),
@@ -853,7 +901,8 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_termination":             chime.ResourceVoiceConnectorTermination(),
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
-			"aws_cloud9_environment_ec2": cloud9.ResourceEnvironmentEC2(),
+			"aws_cloud9_environment_ec2":        cloud9.ResourceEnvironmentEC2(),
+			"aws_cloud9_environment_membership": cloud9.ResourceEnvironmentMembership(),
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.ResourceResource(),
 
@@ -891,6 +940,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_bus_policy":      events.ResourceBusPolicy(),
 			"aws_cloudwatch_event_connection":      events.ResourceConnection(),
 			"aws_cloudwatch_event_permission":      events.ResourcePermission(),
+			"aws_cloudwatch_event_replay":          events.ResourceReplay(),
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
 
@@ -935,6 +985,7 @@ func Provider() *schema.Provider {
 
 			"aws_cognito_identity_provider":          cognitoidp.ResourceIdentityProvider(),
 			"aws_cognito_resource_server":            cognitoidp.ResourceResourceServer(),
+			"aws_cognito_risk_configuration":         cognitoidp.ResourceRiskConfiguration(),
 			"aws_cognito_user_group":                 cognitoidp.ResourceUserGroup(),
 			"aws_cognito_user_pool":                  cognitoidp.ResourceUserPool(),
 			"aws_cognito_user_pool_client":           cognitoidp.ResourceUserPoolClient(),
@@ -989,6 +1040,7 @@ func Provider() *schema.Provider {
 			"aws_dx_hosted_transit_virtual_interface":          directconnect.ResourceHostedTransitVirtualInterface(),
 			"aws_dx_hosted_transit_virtual_interface_accepter": directconnect.ResourceHostedTransitVirtualInterfaceAccepter(),
 			"aws_dx_lag":                       directconnect.ResourceLag(),
+			"aws_dx_macsec_key_association":    directconnect.ResourceMacSecKeyAssociation(),
 			"aws_dx_private_virtual_interface": directconnect.ResourcePrivateVirtualInterface(),
 			"aws_dx_public_virtual_interface":  directconnect.ResourcePublicVirtualInterface(),
 			"aws_dx_transit_virtual_interface": directconnect.ResourceTransitVirtualInterface(),
@@ -1013,9 +1065,11 @@ func Provider() *schema.Provider {
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
 
+			"aws_dynamodb_contributor_insights":          dynamodb.ResourceContributorInsights(),
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
@@ -1116,13 +1170,16 @@ func Provider() *schema.Provider {
 			"aws_vpn_gateway_attachment":                          ec2.ResourceVPNGatewayAttachment(),
 			"aws_vpn_gateway_route_propagation":                   ec2.ResourceVPNGatewayRoutePropagation(),
 
-			"aws_ecr_lifecycle_policy":          ecr.ResourceLifecyclePolicy(),
-			"aws_ecr_registry_policy":           ecr.ResourceRegistryPolicy(),
-			"aws_ecr_replication_configuration": ecr.ResourceReplicationConfiguration(),
-			"aws_ecr_repository":                ecr.ResourceRepository(),
-			"aws_ecr_repository_policy":         ecr.ResourceRepositoryPolicy(),
+			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
+			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
+			"aws_ecr_registry_policy":                 ecr.ResourceRegistryPolicy(),
+			"aws_ecr_registry_scanning_configuration": ecr.ResourceRegistryScanningConfiguration(),
+			"aws_ecr_replication_configuration":       ecr.ResourceReplicationConfiguration(),
+			"aws_ecr_repository":                      ecr.ResourceRepository(),
+			"aws_ecr_repository_policy":               ecr.ResourceRepositoryPolicy(),
 
-			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
+			"aws_ecrpublic_repository":        ecrpublic.ResourceRepository(),
+			"aws_ecrpublic_repository_policy": ecrpublic.ResourceRepositoryPolicy(),
 
 			"aws_ecs_account_setting_default": ecs.ResourceAccountSettingDefault(),
 			"aws_ecs_capacity_provider":       ecs.ResourceCapacityProvider(),
@@ -1130,6 +1187,7 @@ func Provider() *schema.Provider {
 			"aws_ecs_service":                 ecs.ResourceService(),
 			"aws_ecs_tag":                     ecs.ResourceTag(),
 			"aws_ecs_task_definition":         ecs.ResourceTaskDefinition(),
+			"aws_ecs_task_set":                ecs.ResourceTaskSet(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
@@ -1157,9 +1215,11 @@ func Provider() *schema.Provider {
 			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.ResourceConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":            elasticbeanstalk.ResourceEnvironment(),
 
-			"aws_elasticsearch_domain":              elasticsearch.ResourceDomain(),
-			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),
-			"aws_elasticsearch_domain_saml_options": elasticsearch.ResourceDomainSAMLOptions(),
+			"aws_elasticsearch_domain":                      elasticsearch.ResourceDomain(),
+			"aws_elasticsearch_domain_policy":               elasticsearch.ResourceDomainPolicy(),
+			"aws_elasticsearch_domain_saml_options":         elasticsearch.ResourceDomainSAMLOptions(),
+			"aws_elasticsearch_inbound_connection_accepter": elasticsearch.ResourceInboundConnectionAccepter(),
+			"aws_elasticsearch_outbound_connection":         elasticsearch.ResourceOutboundConnection(),
 
 			"aws_elastictranscoder_pipeline": elastictranscoder.ResourcePipeline(),
 			"aws_elastictranscoder_preset":   elastictranscoder.ResourcePreset(),
@@ -1198,6 +1258,17 @@ func Provider() *schema.Provider {
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
 			"aws_fms_policy":        fms.ResourcePolicy(),
 
+			"aws_frauddetector_detector":         frauddetector.ResourceDetector(),
+			"aws_frauddetector_detector_version": frauddetector.ResourceDetectorVersion(),
+			"aws_frauddetector_entity_type":      frauddetector.ResourceEntityType(),
+			"aws_frauddetector_event_type":       frauddetector.ResourceEventType(),
+			"aws_frauddetector_label":            frauddetector.ResourceLabel(),
+			"aws_frauddetector_model":            frauddetector.ResourceModel(),
+			"aws_frauddetector_model_version":    frauddetector.ResourceModelVersion(),
+			"aws_frauddetector_outcome":          frauddetector.ResourceOutcome(),
+			"aws_frauddetector_rule":             frauddetector.ResourceRule(),
+			"aws_frauddetector_variable":         frauddetector.ResourceVariable(),
+
 			"aws_fsx_backup":                        fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":            fsx.ResourceLustreFileSystem(),
 			"aws_fsx_ontap_file_system":             fsx.ResourceOntapFileSystem(),
@@ -1210,8 +1281,9 @@ func Provider() *schema.Provider {
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
 
-			"aws_glacier_vault":      glacier.ResourceVault(),
-			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
+			"aws_glacier_data_retrieval_policy": glacier.ResourceDataRetrievalPolicy(),
+			"aws_glacier_vault":                 glacier.ResourceVault(),
+			"aws_glacier_vault_lock":            glacier.ResourceVaultLock(),
 
 			"aws_globalaccelerator_accelerator":    globalaccelerator.ResourceAccelerator(),
 			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
@@ -1246,29 +1318,32 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":              iam.ResourceAccessKey(),
-			"aws_iam_account_alias":           iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy": iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                   iam.ResourceGroup(),
-			"aws_iam_group_membership":        iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":            iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment": iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":        iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider": iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":       iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                    iam.ResourceRole(),
-			"aws_iam_role_policy":             iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":  iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":           iam.ResourceSamlProvider(),
-			"aws_iam_server_certificate":      iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":     iam.ResourceServiceLinkedRole(),
-			"aws_iam_user":                    iam.ResourceUser(),
-			"aws_iam_user_group_membership":   iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":      iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":             iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":  iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":            iam.ResourceUserSSHKey(),
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSamlProvider(),
+			"aws_iam_security_token_service_preferences": iam.ResourceSecurityTokenServicePreferences(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1297,6 +1372,9 @@ func Provider() *schema.Provider {
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
 
+			"aws_kendra_experience":                   kendra.ResourceExperience(),
+			"aws_kendra_query_suggestions_block_list": kendra.ResourceQuerySuggestionsBlockList(),
+
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
@@ -1308,6 +1386,7 @@ func Provider() *schema.Provider {
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
+			"aws_kms_custom_key_store":     kms.ResourceCustomKeyStore(),
 			"aws_kms_external_key":         kms.ResourceExternalKey(),
 			"aws_kms_grant":                kms.ResourceGrant(),
 			"aws_kms_key":                  kms.ResourceKey(),
@@ -1361,6 +1440,8 @@ func Provider() *schema.Provider {
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
 
+			"aws_mgn_replication_configuration_template": mgn.ResourceReplicationConfigurationTemplate(),
+
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
 
@@ -1381,6 +1462,9 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
 
+			"aws_opensearch_package":             opensearch.ResourcePackage(),
+			"aws_opensearch_package_association": opensearch.ResourcePackageAssociation(),
+
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ganglia_layer":    opsworks.ResourceGangliaLayer(),
@@ -1432,6 +1516,7 @@ func Provider() *schema.Provider {
 			"aws_db_cluster_snapshot":           rds.ResourceClusterSnapshot(),
 			"aws_db_event_subscription":         rds.ResourceEventSubscription(),
 			"aws_db_instance":                   rds.ResourceInstance(),
+			"aws_db_instance_activity_stream":   rds.ResourceInstanceActivityStream(),
 			"aws_db_instance_role_association":  rds.ResourceInstanceRoleAssociation(),
 			"aws_db_option_group":               rds.ResourceOptionGroup(),
 			"aws_db_parameter_group":            rds.ResourceParameterGroup(),
@@ -1443,6 +1528,7 @@ func Provider() *schema.Provider {
 			"aws_db_snapshot":                   rds.ResourceSnapshot(),
 			"aws_db_subnet_group":               rds.ResourceSubnetGroup(),
 			"aws_rds_cluster":                   rds.ResourceCluster(),
+			"aws_rds_cluster_activity_stream":   rds.ResourceClusterActivityStream(),
 			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(),
 			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
@@ -1459,6 +1545,11 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
 			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
 
+			"aws_rekognition_collection":       rekognition.ResourceCollection(),
+			"aws_rekognition_project":          rekognition.ResourceProject(),
+			"aws_rekognition_project_version":  rekognition.ResourceProjectVersion(),
+			"aws_rekognition_stream_processor": rekognition.ResourceStreamProcessor(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
@@ -1471,6 +1562,8 @@ func Provider() *schema.Provider {
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
 
+			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
+
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control": route53recoverycontrolconfig.ResourceRoutingControl(),
@@ -1494,9 +1587,12 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
 			"aws_s3_bucket":                                   s3.ResourceBucket(),
+			"aws_s3_bucket_acl":                               s3.ResourceBucketACL(),
 			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
 			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
 			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":           s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                           s3.ResourceBucketLogging(),
 			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
 			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
 			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
@@ -1504,9 +1600,11 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
 			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
 			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_versioning":                        s3.ResourceBucketVersioning(),
 			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
+			"aws_s3control_access_point":                      s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
 			"aws_s3_account_public_access_block":              s3control.ResourceAccountPublicAccessBlock(),
 			"aws_s3control_bucket":                            s3control.ResourceBucket(),
@@ -1628,6 +1726,8 @@ func Provider() *schema.Provider {
 			"aws_ssm_maintenance_window":        ssm.ResourceMaintenanceWindow(),
 			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(),
 			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),
+			"aws_ssm_ops_item":                  ssm.ResourceOpsItem(),
+			"aws_ssm_ops_metadata":              ssm.ResourceOpsMetadata(),
 			"aws_ssm_parameter":                 ssm.ResourceParameter(),
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
@@ -1656,6 +1756,11 @@ func Provider() *schema.Provider {
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
+			"aws_transcribe_call_analytics_category": transcribe.ResourceCallAnalyticsCategory(),
+			"aws_transcribe_language_model":          transcribe.ResourceLanguageModel(),
+			"aws_transcribe_vocabulary":              transcribe.ResourceVocabulary(),
+			"aws_transcribe_vocabulary_filter":       transcribe.ResourceVocabularyFilter(),
+
 			"aws_transfer_access":  transfer.ResourceAccess(),
 			"aws_transfer_server":  transfer.ResourceServer(),
 			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
@@ -1747,6 +1852,9 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"retry_max_attempts": "The maximum number of attempts, including the initial request,\n" +
+			"for an AWS API request. Overrides `max_retries`.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
@@ -1800,68 +1908,52 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		TerraformVersion:        terraformVersion,
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
-
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
+	for _, v := range d.Get("assume_role").([]interface{}) {
+		if v == nil {
+			continue
 		}
 
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
+		assumeRole := expandProviderAssumeRole(v.(map[string]interface{}))
 
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
+		if assumeRole.RoleARN == "" {
+			continue
 		}
 
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
+		config.AssumeRole = append(config.AssumeRole, assumeRole)
 
-				if !ok {
-					continue
-				}
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.SourceIdentity)
+	}
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
-			}
-		}
+	if l, ok := d.Get("retry").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
 
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
+		if v, ok := m["max_attempts"].(int); ok && v != 0 {
+			config.RetryMaxAttempts = v
 		}
 
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
+		if v, ok := m["throttle_backoff_multiplier"].(float64); ok && v != 0 {
+			config.RetryThrottleBackoffMultiplier = v
 		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
+		if serviceSet, ok := m["service"].(*schema.Set); ok && serviceSet.Len() > 0 {
+			config.RetryServiceMaxAttempts = make(map[string]int)
 
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
+			for _, serviceRaw := range serviceSet.List() {
+				service, ok := serviceRaw.(map[string]interface{})
 
 				if !ok {
 					continue
 				}
 
-				config.AssumeRoleTags[k] = v
-			}
-		}
-
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+				serviceKey, err := conns.ServiceForHCLKey(service["name"].(string))
 
-				if !ok {
-					continue
+				if err != nil {
+					return nil, fmt.Errorf("failed to configure retry (%s): %w", service["name"].(string), err)
 				}
 
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
+				config.RetryServiceMaxAttempts[serviceKey] = service["max_attempts"].(int)
 			}
 		}
-
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1899,9 +1991,9 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Roles to assume prior to making API calls. Multiple roles are assumed in order, each using the credentials of the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1940,6 +2032,15 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: "Identifier for the assumed role session.",
 				},
+				"source_identity": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Source identity specified by the principal assuming the role.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]*$`), ""),
+					),
+				},
 				"tags": {
 					Type:        schema.TypeMap,
 					Optional:    true,
@@ -1957,6 +2058,53 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to retry AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["retry_max_attempts"],
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"service": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Per-service overrides of the maximum number of attempts.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								Description:  "The maximum number of attempts, including the initial request, for requests to this service.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The service, using the same names as the `endpoints` configuration block.",
+								ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+							},
+						},
+					},
+				},
+				"throttle_backoff_multiplier": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      1.0,
+					Description:  "Multiplier applied to the backoff delays used when requests are throttled.",
+					ValidateFunc: validation.FloatBetween(0.1, 10),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1978,6 +2126,74 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderAssumeRole(m map[string]interface{}) *conns.AssumeRole {
+	assumeRole := &conns.AssumeRole{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := m["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := m["source_identity"].(string); ok && v != "" {
+		assumeRole.SourceIdentity = v
+	}
+
+	if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+		for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+			transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+		}
+	}
+
+	return assumeRole
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2008,5 +2224,15 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["no_refresh_services"].(*schema.Set); ok {
+		for _, service := range v.List() {
+			ignoreConfig.NoRefreshServices = append(ignoreConfig.NoRefreshServices, service.(string))
+		}
+	}
+
+	if v, ok := m["refresh_tags"].(bool); ok {
+		ignoreConfig.NoRefresh = !v
+	}
+
 	return ignoreConfig
 }
@@ -26,6 +26,8 @@ const (
 	accessAnalyzerOrganizationCreationTimeout = 10 * time.Minute
 )
 
+var regexpValidName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`)
+
 func ResourceAnalyzer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAnalyzerCreate,
@@ -43,7 +45,7 @@ func ResourceAnalyzer() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 255),
-					validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`), "must begin with a letter and contain only alphanumeric, underscore, period, or hyphen characters"),
+					validation.StringMatch(regexpValidName, "must begin with a letter and contain only alphanumeric, underscore, period, or hyphen characters"),
 				),
 			},
 			"arn": {
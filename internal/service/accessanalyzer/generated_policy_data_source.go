@@ -0,0 +1,215 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceGeneratedPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGeneratedPolicyRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudtrail_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"trail": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_regions": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"cloudtrail_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"regions": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"include_resource_placeholders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_service_level_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceGeneratedPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	principalARN := d.Get("principal_arn").(string)
+	input := &accessanalyzer.StartPolicyGenerationInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		PolicyGenerationDetails: &accessanalyzer.PolicyGenerationDetails{
+			PrincipalArn: aws.String(principalARN),
+		},
+	}
+
+	if v, ok := d.GetOk("cloudtrail_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CloudTrailDetails = expandCloudTrailDetails(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting Access Analyzer Policy Generation: %s", input)
+	output, err := conn.StartPolicyGeneration(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting Access Analyzer Policy Generation (%s): %w", principalARN, err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+
+	if _, err := waitPolicyGenerationSucceeded(conn, jobID, d.Timeout(schema.TimeoutRead)); err != nil {
+		return fmt.Errorf("error waiting for Access Analyzer Policy Generation (%s) to succeed: %w", jobID, err)
+	}
+
+	generatedPolicy, err := FindGeneratedPolicyByJobID(conn, &accessanalyzer.GetGeneratedPolicyInput{
+		IncludeResourcePlaceholders: aws.Bool(d.Get("include_resource_placeholders").(bool)),
+		IncludeServiceLevelTemplate: aws.Bool(d.Get("include_service_level_template").(bool)),
+		JobId:                       aws.String(jobID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Generated Policy (%s): %w", jobID, err)
+	}
+
+	d.SetId(jobID)
+	d.Set("job_id", jobID)
+
+	var policies []string
+
+	if v := generatedPolicy.GeneratedPolicyResult; v != nil {
+		for _, v := range v.GeneratedPolicies {
+			if v == nil {
+				continue
+			}
+
+			policies = append(policies, aws.StringValue(v.Policy))
+		}
+	}
+
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("error setting policies: %w", err)
+	}
+
+	return nil
+}
+
+func expandCloudTrailDetails(tfMap map[string]interface{}) *accessanalyzer.CloudTrailDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &accessanalyzer.CloudTrailDetails{}
+
+	if v, ok := tfMap["access_role_arn"].(string); ok && v != "" {
+		apiObject.AccessRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.EndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["trail"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Trails = expandTrails(v)
+	}
+
+	return apiObject
+}
+
+func expandTrails(tfList []interface{}) []*accessanalyzer.Trail {
+	var apiObjects []*accessanalyzer.Trail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &accessanalyzer.Trail{}
+
+		if v, ok := tfMap["all_regions"].(bool); ok && v {
+			apiObject.AllRegions = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["cloudtrail_arn"].(string); ok && v != "" {
+			apiObject.CloudTrailArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Regions = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,77 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccessAnalyzerGeneratedPolicyDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_accessanalyzer_generated_policy.test"
+
+	// Policy generation analyzes existing CloudTrail activity, so a trail and a
+	// role that can read it must already exist.
+	trailARN := os.Getenv("ACCESSANALYZER_TRAIL_ARN")
+	accessRoleARN := os.Getenv("ACCESSANALYZER_ACCESS_ROLE_ARN")
+
+	if trailARN == "" || accessRoleARN == "" {
+		t.Skip("Environment variables ACCESSANALYZER_TRAIL_ARN and ACCESSANALYZER_ACCESS_ROLE_ARN must be set")
+	}
+
+	startTime := time.Now().UTC().AddDate(0, 0, -7).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeneratedPolicyDataSourceConfig(rName, trailARN, accessRoleARN, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policies.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGeneratedPolicyDataSourceConfig(rName, trailARN, accessRoleARN, startTime string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+data "aws_accessanalyzer_generated_policy" "test" {
+  principal_arn = aws_iam_role.test.arn
+
+  cloudtrail_details {
+    access_role_arn = %[3]q
+    start_time      = %[4]q
+
+    trail {
+      cloudtrail_arn = %[2]q
+      all_regions    = true
+    }
+  }
+}
+`, rName, trailARN, accessRoleARN, startTime)
+}
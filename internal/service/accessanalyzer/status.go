@@ -0,0 +1,26 @@
+package accessanalyzer
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusPolicyGeneration(conn *accessanalyzer.AccessAnalyzer, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGeneratedPolicyByJobID(conn, &accessanalyzer.GetGeneratedPolicyInput{
+			JobId: aws.String(jobID),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobDetails.Status), nil
+	}
+}
@@ -0,0 +1,33 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitPolicyGenerationSucceeded(conn *accessanalyzer.AccessAnalyzer, jobID string, timeout time.Duration) (*accessanalyzer.GetGeneratedPolicyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accessanalyzer.JobStatusInProgress},
+		Target:  []string{accessanalyzer.JobStatusSucceeded},
+		Refresh: statusPolicyGeneration(conn, jobID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*accessanalyzer.GetGeneratedPolicyOutput); ok {
+		if jobError := output.JobDetails.JobError; jobError != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(jobError.Code), aws.StringValue(jobError.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
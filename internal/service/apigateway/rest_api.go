@@ -80,6 +80,13 @@ func ResourceRestAPI() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"put_rest_api_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      apigateway.PutModeOverwrite,
+				ValidateFunc: validation.StringInSlice(apigateway.PutMode_Values(), false),
+			},
+
 			"minimum_compression_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -205,7 +212,7 @@ func resourceRestAPICreate(d *schema.ResourceData, meta interface{}) error {
 
 		input := &apigateway.PutRestApiInput{
 			RestApiId: gateway.Id,
-			Mode:      aws.String(apigateway.PutModeOverwrite),
+			Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 			Body:      []byte(body.(string)),
 		}
 
@@ -386,6 +393,11 @@ func resourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("binary_media_types", api.BinaryMediaTypes)
 
+	// The import mode is not returned by the API.
+	if _, ok := d.GetOk("put_rest_api_mode"); !ok {
+		d.Set("put_rest_api_mode", apigateway.PutModeOverwrite)
+	}
+
 	execution_arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "execute-api",
@@ -572,7 +584,7 @@ func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			input := &apigateway.PutRestApiInput{
 				RestApiId: aws.String(d.Id()),
-				Mode:      aws.String(apigateway.PutModeOverwrite),
+				Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 				Body:      []byte(body.(string)),
 			}
 
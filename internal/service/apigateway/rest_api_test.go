@@ -525,6 +525,52 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_putRestAPIMode(t *testing.T) {
+	var conf apigateway.RestApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIPutRestAPIModeConfig(rName, "/test", apigateway.PutModeMerge),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/test"}),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeMerge),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+			},
+			// Merging keeps routes from the previous specification
+			{
+				Config: testAccRestAPIPutRestAPIModeConfig(rName, "/update", apigateway.PutModeMerge),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/test", "/update"}),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeMerge),
+				),
+			},
+			{
+				Config: testAccRestAPIPutRestAPIModeConfig(rName, "/overwrite", apigateway.PutModeOverwrite),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/overwrite"}),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeOverwrite),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 	var conf apigateway.RestApi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1950,6 +1996,45 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, basePath)
 }
 
+func testAccRestAPIPutRestAPIModeConfig(rName string, basePath string, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name              = %[1]q
+  put_rest_api_mode = %[3]q
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "test"
+      version = "2017-04-20T04:08:08Z"
+    }
+    schemes = ["https"]
+    paths = {
+      %[2]q = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+          x-amazon-apigateway-integration = {
+            httpMethod = "GET"
+            type       = "HTTP"
+            responses = {
+              default = {
+                statusCode = 200
+              }
+            }
+            uri = "https://api.example.com/"
+          }
+        }
+      }
+    }
+  })
+}
+`, rName, basePath, mode)
+}
+
 func testAccRestAPIDescriptionConfig(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
@@ -0,0 +1,84 @@
+package apigatewayv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.0"}, false),
+			},
+			"include_extensions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"output_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"JSON", "YAML"}, false),
+			},
+			"specification": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OAS30"}, false),
+			},
+			"stage_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceExportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	input := &apigatewayv2.ExportApiInput{
+		ApiId:             aws.String(apiID),
+		IncludeExtensions: aws.Bool(d.Get("include_extensions").(bool)),
+		OutputType:        aws.String(d.Get("output_type").(string)),
+		Specification:     aws.String(d.Get("specification").(string)),
+	}
+
+	if v, ok := d.GetOk("export_version"); ok {
+		input.ExportVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("stage_name"); ok {
+		input.StageName = aws.String(v.(string))
+	}
+
+	output, err := conn.ExportApi(input)
+
+	if err != nil {
+		return fmt.Errorf("error exporting API Gateway v2 API (%s): %w", apiID, err)
+	}
+
+	d.SetId(apiID)
+
+	d.Set("body", string(output.Body))
+
+	return nil
+}
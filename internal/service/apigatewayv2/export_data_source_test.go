@@ -0,0 +1,94 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2ExportDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_export.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", "aws_apigatewayv2_api.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "body"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2ExportDataSource_stage(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_export.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportDataSourceConfigStage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", "aws_apigatewayv2_api.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage_name", "aws_apigatewayv2_stage.test", "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "body"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExportDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /test"
+}
+`, rName)
+}
+
+func testAccExportDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccExportDataSourceConfigBase(rName), `
+data "aws_apigatewayv2_export" "test" {
+  api_id        = aws_apigatewayv2_route.test.api_id
+  specification = "OAS30"
+  output_type   = "JSON"
+}
+`)
+}
+
+func testAccExportDataSourceConfigStage(rName string) string {
+	return acctest.ConfigCompose(testAccExportDataSourceConfigBase(rName), fmt.Sprintf(`
+resource "aws_apigatewayv2_stage" "test" {
+  api_id = aws_apigatewayv2_route.test.api_id
+  name   = %[1]q
+}
+
+data "aws_apigatewayv2_export" "test" {
+  api_id        = aws_apigatewayv2_api.test.id
+  specification = "OAS30"
+  output_type   = "YAML"
+  stage_name    = aws_apigatewayv2_stage.test.name
+}
+`, rName))
+}
@@ -1,6 +1,8 @@
 package apigatewayv2
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -104,3 +106,43 @@ func FindDomainName(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDoma
 
 	return output, nil
 }
+
+// FindRouteSettingsByThreePartKey returns the route settings for the specified route key in the specified stage.
+// Returns NotFoundError if no route settings are found.
+func FindRouteSettingsByThreePartKey(conn *apigatewayv2.ApiGatewayV2, apiID, stageName, routeKey string) (*apigatewayv2.RouteSettings, error) {
+	input := &apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiID),
+		StageName: aws.String(stageName),
+	}
+
+	output, err := conn.GetStage(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	routeSettings, ok := output.RouteSettings[routeKey]
+
+	if !ok || routeSettings == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("route settings for route key (%s) not found", routeKey),
+			LastRequest: input,
+		}
+	}
+
+	return routeSettings, nil
+}
@@ -0,0 +1,25 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+const routeSettingsResourceIDSeparator = ","
+
+func RouteSettingsCreateResourceID(apiID, stageName, routeKey string) string {
+	parts := []string{apiID, stageName, routeKey}
+	id := strings.Join(parts, routeSettingsResourceIDSeparator)
+
+	return id
+}
+
+func RouteSettingsParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, routeSettingsResourceIDSeparator, 3)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected API-ID%[2]sSTAGE-NAME%[2]sROUTE-KEY", id, routeSettingsResourceIDSeparator)
+}
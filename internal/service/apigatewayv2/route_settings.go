@@ -0,0 +1,154 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRouteSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteSettingsPut,
+		Read:   resourceRouteSettingsRead,
+		Update: resourceRouteSettingsPut,
+		Delete: resourceRouteSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"detailed_metrics_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"route_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"stage_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"throttling_burst_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"throttling_rate_limit": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceRouteSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	stageName := d.Get("stage_name").(string)
+	routeKey := d.Get("route_key").(string)
+	id := RouteSettingsCreateResourceID(apiID, stageName, routeKey)
+
+	routeSettings := &apigatewayv2.RouteSettings{
+		DetailedMetricsEnabled: aws.Bool(d.Get("detailed_metrics_enabled").(bool)),
+		ThrottlingBurstLimit:   aws.Int64(int64(d.Get("throttling_burst_limit").(int))),
+		ThrottlingRateLimit:    aws.Float64(d.Get("throttling_rate_limit").(float64)),
+	}
+
+	input := &apigatewayv2.UpdateStageInput{
+		ApiId: aws.String(apiID),
+		RouteSettings: map[string]*apigatewayv2.RouteSettings{
+			routeKey: routeSettings,
+		},
+		StageName: aws.String(stageName),
+	}
+
+	log.Printf("[DEBUG] Putting API Gateway v2 route settings: %s", input)
+	_, err := conn.UpdateStage(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting API Gateway v2 route settings (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRouteSettingsRead(d, meta)
+}
+
+func resourceRouteSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID, stageName, routeKey, err := RouteSettingsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	routeSettings, err := FindRouteSettingsByThreePartKey(conn, apiID, stageName, routeKey)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway v2 route settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 route settings (%s): %w", d.Id(), err)
+	}
+
+	d.Set("api_id", apiID)
+	d.Set("detailed_metrics_enabled", routeSettings.DetailedMetricsEnabled)
+	d.Set("route_key", routeKey)
+	d.Set("stage_name", stageName)
+	d.Set("throttling_burst_limit", routeSettings.ThrottlingBurstLimit)
+	d.Set("throttling_rate_limit", routeSettings.ThrottlingRateLimit)
+
+	return nil
+}
+
+func resourceRouteSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID, stageName, routeKey, err := RouteSettingsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 route settings: %s", d.Id())
+	_, err = conn.DeleteRouteSettings(&apigatewayv2.DeleteRouteSettingsInput{
+		ApiId:     aws.String(apiID),
+		RouteKey:  aws.String(routeKey),
+		StageName: aws.String(stageName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 route settings (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
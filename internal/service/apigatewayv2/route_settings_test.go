@@ -0,0 +1,180 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigatewayv2 "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAPIGatewayV2RouteSettings_basic(t *testing.T) {
+	var v apigatewayv2.RouteSettings
+	resourceName := "aws_apigatewayv2_route_settings.test"
+	stageResourceName := "aws_apigatewayv2_stage.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRouteSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteSettingsConfig(rName, false, 100, 10.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_apigatewayv2_api.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "detailed_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "route_key", "GET /test"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage_name", stageResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "throttling_burst_limit", "100"),
+					resource.TestCheckResourceAttr(resourceName, "throttling_rate_limit", "10.5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRouteSettingsConfig(rName, true, 200, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "detailed_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "route_key", "GET /test"),
+					resource.TestCheckResourceAttr(resourceName, "throttling_burst_limit", "200"),
+					resource.TestCheckResourceAttr(resourceName, "throttling_rate_limit", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2RouteSettings_disappears(t *testing.T) {
+	var v apigatewayv2.RouteSettings
+	resourceName := "aws_apigatewayv2_route_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRouteSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteSettingsConfig(rName, false, 100, 10.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteSettingsExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapigatewayv2.ResourceRouteSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRouteSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_route_settings" {
+			continue
+		}
+
+		apiID, stageName, routeKey, err := tfapigatewayv2.RouteSettingsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfapigatewayv2.FindRouteSettingsByThreePartKey(conn, apiID, stageName, routeKey)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 route settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRouteSettingsExists(n string, v *apigatewayv2.RouteSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 route settings ID is set")
+		}
+
+		apiID, stageName, routeKey, err := tfapigatewayv2.RouteSettingsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
+
+		output, err := tfapigatewayv2.FindRouteSettingsByThreePartKey(conn, apiID, stageName, routeKey)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRouteSettingsConfig(rName string, detailedMetricsEnabled bool, burstLimit int, rateLimit float64) string {
+	return acctest.ConfigCompose(
+		testAccStageConfig_apiHTTP(rName),
+		fmt.Sprintf(`
+resource "aws_apigatewayv2_integration" "test" {
+  api_id             = aws_apigatewayv2_api.test.id
+  integration_type   = "HTTP_PROXY"
+  integration_method = "GET"
+  integration_uri    = "https://example.com/"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /test"
+  target    = "integrations/${aws_apigatewayv2_integration.test.id}"
+}
+
+resource "aws_apigatewayv2_stage" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+  name   = %[1]q
+
+  lifecycle {
+    ignore_changes = [route_settings]
+  }
+}
+
+resource "aws_apigatewayv2_route_settings" "test" {
+  api_id     = aws_apigatewayv2_api.test.id
+  stage_name = aws_apigatewayv2_stage.test.name
+  route_key  = aws_apigatewayv2_route.test.route_key
+
+  detailed_metrics_enabled = %[2]t
+  throttling_burst_limit   = %[3]d
+  throttling_rate_limit    = %[4]g
+}
+`, rName, detailedMetricsEnabled, burstLimit, rateLimit))
+}
@@ -0,0 +1,261 @@
+package appintegrations
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataIntegrationCreate,
+		Read:   resourceDataIntegrationRead,
+		Update: resourceDataIntegrationUpdate,
+		Delete: resourceDataIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\/\._\-]+$`), "should only contain alphanumeric characters, forward slashes, periods, underscores and hyphens"),
+				),
+			},
+			"schedule_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_execution_from": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"object": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\/\._\-]+$`), "should only contain alphanumeric characters, forward slashes, periods, underscores and hyphens"),
+							),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"source_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1000),
+					validation.StringMatch(regexp.MustCompile(`^\w+\:\/\/\w+\/[\w/!@#+=.-]+$`), "should be a valid source URI, e.g. Salesforce://AppFlow/Account"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appintegrationsservice.CreateDataIntegrationInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		KmsKey:         aws.String(d.Get("kms_key").(string)),
+		Name:           aws.String(name),
+		ScheduleConfig: expandScheduleConfig(d.Get("schedule_config").([]interface{})),
+		SourceURI:      aws.String(d.Get("source_uri").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AppIntegrations Data Integration: %s", input)
+	output, err := conn.CreateDataIntegration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppIntegrations Data Integration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceDataIntegrationRead(d, meta)
+}
+
+func resourceDataIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDataIntegrationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Data Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppIntegrations Data Integration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("kms_key", output.KmsKey)
+	d.Set("name", output.Name)
+	d.Set("source_uri", output.SourceURI)
+
+	if err := d.Set("schedule_config", flattenScheduleConfig(output.ScheduleConfiguration)); err != nil {
+		return fmt.Errorf("error setting schedule_config: %w", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+
+	if d.HasChanges("description", "name") {
+		input := &appintegrationsservice.UpdateDataIntegrationInput{
+			Description: aws.String(d.Get("description").(string)),
+			Identifier:  aws.String(d.Id()),
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating AppIntegrations Data Integration: %s", input)
+		_, err := conn.UpdateDataIntegration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppIntegrations Data Integration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppIntegrations Data Integration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDataIntegrationRead(d, meta)
+}
+
+func resourceDataIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+
+	log.Printf("[DEBUG] Deleting AppIntegrations Data Integration: %s", d.Id())
+	_, err := conn.DeleteDataIntegration(&appintegrationsservice.DeleteDataIntegrationInput{
+		DataIntegrationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppIntegrations Data Integration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandScheduleConfig(tfList []interface{}) *appintegrationsservice.ScheduleConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appintegrationsservice.ScheduleConfiguration{}
+
+	if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+		apiObject.FirstExecutionFrom = aws.String(v)
+	}
+
+	if v, ok := tfMap["object"].(string); ok && v != "" {
+		apiObject.Object = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression"].(string); ok && v != "" {
+		apiObject.ScheduleExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenScheduleConfig(apiObject *appintegrationsservice.ScheduleConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"first_execution_from": aws.StringValue(apiObject.FirstExecutionFrom),
+		"object":               aws.StringValue(apiObject.Object),
+		"schedule_expression":  aws.StringValue(apiObject.ScheduleExpression),
+	}
+
+	return []interface{}{tfMap}
+}
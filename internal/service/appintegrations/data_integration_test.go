@@ -0,0 +1,169 @@
+package appintegrations_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappintegrations "github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppIntegrationsDataIntegration_basic(t *testing.T) {
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_data_integration.test"
+	sourceURI := testAccDataIntegrationSourceURI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIntegrationConfig(rName, "description1", sourceURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataIntegrationExists(resourceName, &dataIntegration),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.0.first_execution_from", "1439788442681"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.0.object", "Account"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "source_uri", sourceURI),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataIntegrationConfig(rName2, "description2", sourceURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataIntegrationExists(resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_disappears(t *testing.T) {
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_data_integration.test"
+	sourceURI := testAccDataIntegrationSourceURI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIntegrationConfig(rName, "disappears", sourceURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataIntegrationExists(resourceName, &dataIntegration),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappintegrations.ResourceDataIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataIntegrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appintegrations_data_integration" {
+			continue
+		}
+
+		_, err := tfappintegrations.FindDataIntegrationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppIntegrations Data Integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataIntegrationExists(n string, v *appintegrationsservice.GetDataIntegrationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppIntegrations Data Integration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn
+
+		output, err := tfappintegrations.FindDataIntegrationByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccDataIntegrationSourceURI returns the URI of an existing Amazon AppFlow
+// connector profile object, e.g. Salesforce://AppFlow/test.
+func testAccDataIntegrationSourceURI(t *testing.T) string {
+	v := os.Getenv("AWS_APPINTEGRATIONS_SOURCE_URI")
+
+	if v == "" {
+		t.Skip("Environment variable AWS_APPINTEGRATIONS_SOURCE_URI is not set")
+	}
+
+	return v
+}
+
+func testAccDataIntegrationConfig(rName, description, sourceURI string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_appintegrations_data_integration" "test" {
+  name        = %[1]q
+  description = %[2]q
+  kms_key     = aws_kms_key.test.arn
+  source_uri  = %[3]q
+
+  schedule_config {
+    first_execution_from = "1439788442681"
+    object               = "Account"
+    schedule_expression  = "rate(1 hour)"
+  }
+}
+`, rName, description, sourceURI)
+}
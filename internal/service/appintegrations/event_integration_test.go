@@ -0,0 +1,235 @@
+package appintegrations_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappintegrations "github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppIntegrationsEventIntegration_basic(t *testing.T) {
+	var eventIntegration appintegrationsservice.GetEventIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_event_integration.test"
+	sourceName := testAccEventIntegrationSourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationConfig(rName, "description1", sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName, &eventIntegration),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "app-integrations", fmt.Sprintf("event-integration/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "eventbridge_bus", "default"),
+					resource.TestCheckResourceAttr(resourceName, "event_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_filter.0.source", sourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventIntegrationConfig(rName, "description2", sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName, &eventIntegration),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsEventIntegration_tags(t *testing.T) {
+	var eventIntegration appintegrationsservice.GetEventIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_event_integration.test"
+	sourceName := testAccEventIntegrationSourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationConfigTags1(rName, sourceName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName, &eventIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventIntegrationConfigTags2(rName, sourceName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName, &eventIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEventIntegrationConfigTags1(rName, sourceName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName, &eventIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsEventIntegration_disappears(t *testing.T) {
+	var eventIntegration appintegrationsservice.GetEventIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_event_integration.test"
+	sourceName := testAccEventIntegrationSourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationConfig(rName, "disappears", sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName, &eventIntegration),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappintegrations.ResourceEventIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventIntegrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appintegrations_event_integration" {
+			continue
+		}
+
+		_, err := tfappintegrations.FindEventIntegrationByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppIntegrations Event Integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEventIntegrationExists(n string, v *appintegrationsservice.GetEventIntegrationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppIntegrations Event Integration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn
+
+		output, err := tfappintegrations.FindEventIntegrationByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccEventIntegrationSourceName returns the partner event source to filter on.
+// The source does not need to exist for the event integration to be created.
+func testAccEventIntegrationSourceName() string {
+	if v := os.Getenv("AWS_APPINTEGRATIONS_SOURCE_NAME"); v != "" {
+		return v
+	}
+
+	return "aws.partner/examplepartner.com"
+}
+
+func testAccEventIntegrationConfig(rName, description, sourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = %[3]q
+  }
+}
+`, rName, description, sourceName)
+}
+
+func testAccEventIntegrationConfigTags1(rName, sourceName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, sourceName, tagKey1, tagValue1)
+}
+
+func testAccEventIntegrationConfigTags2(rName, sourceName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, sourceName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
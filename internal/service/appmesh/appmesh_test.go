@@ -7,12 +7,14 @@ import (
 func TestAccAppMesh_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"GatewayRoute": {
-			"basic":      testAccGatewayRoute_basic,
-			"disappears": testAccGatewayRoute_disappears,
-			"grpcRoute":  testAccGatewayRoute_GRPCRoute,
-			"httpRoute":  testAccGatewayRoute_HTTPRoute,
-			"http2Route": testAccGatewayRoute_HTTP2Route,
-			"tags":       testAccGatewayRoute_Tags,
+			"basic":                     testAccGatewayRoute_basic,
+			"disappears":                testAccGatewayRoute_disappears,
+			"grpcRoute":                 testAccGatewayRoute_GRPCRoute,
+			"grpcRouteWithMetadata":     testAccGatewayRoute_GRPCRouteWithMetadata,
+			"httpRoute":                 testAccGatewayRoute_HTTPRoute,
+			"httpRouteWithMatchRewrite": testAccGatewayRoute_HTTPRouteWithMatchAndRewrite,
+			"http2Route":                testAccGatewayRoute_HTTP2Route,
+			"tags":                      testAccGatewayRoute_Tags,
 		},
 		"Mesh": {
 			"basic":        testAccMesh_basic,
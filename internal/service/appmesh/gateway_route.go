@@ -80,31 +80,19 @@ func ResourceGatewayRoute() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
-												"target": {
+												"rewrite": {
 													Type:     schema.TypeList,
-													Required: true,
-													MinItems: 1,
+													Optional: true,
+													MinItems: 0,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
-															"virtual_service": {
-																Type:     schema.TypeList,
-																Required: true,
-																MinItems: 1,
-																MaxItems: 1,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"virtual_service_name": {
-																			Type:         schema.TypeString,
-																			Required:     true,
-																			ValidateFunc: validation.StringLenBetween(1, 255),
-																		},
-																	},
-																},
-															},
+															"hostname": appmeshGatewayRouteHostnameRewriteSchema(),
 														},
 													},
 												},
+
+												"target": appmeshGatewayRouteTargetSchema(),
 											},
 										},
 									},
@@ -116,143 +104,35 @@ func ResourceGatewayRoute() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
-												"service_name": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-								},
-							},
-							ExactlyOneOf: []string{
-								"spec.0.grpc_route",
-								"spec.0.http2_route",
-								"spec.0.http_route",
-							},
-						},
+												"hostname": appmeshGatewayRouteHostnameMatchSchema(),
 
-						"http2_route": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MinItems: 0,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"action": {
-										Type:     schema.TypeList,
-										Required: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"target": {
-													Type:     schema.TypeList,
-													Required: true,
-													MinItems: 1,
-													MaxItems: 1,
+												"metadata": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 10,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
-															"virtual_service": {
-																Type:     schema.TypeList,
-																Required: true,
-																MinItems: 1,
-																MaxItems: 1,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"virtual_service_name": {
-																			Type:         schema.TypeString,
-																			Required:     true,
-																			ValidateFunc: validation.StringLenBetween(1, 255),
-																		},
-																	},
-																},
+															"invert": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  false,
 															},
-														},
-													},
-												},
-											},
-										},
-									},
 
-									"match": {
-										Type:     schema.TypeList,
-										Required: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"prefix": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
-												},
-											},
-										},
-									},
-								},
-							},
-							ExactlyOneOf: []string{
-								"spec.0.grpc_route",
-								"spec.0.http2_route",
-								"spec.0.http_route",
-							},
-						},
+															"match": appmeshGatewayRouteHeaderMatchMethodSchema(),
 
-						"http_route": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MinItems: 0,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"action": {
-										Type:     schema.TypeList,
-										Required: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"target": {
-													Type:     schema.TypeList,
-													Required: true,
-													MinItems: 1,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"virtual_service": {
-																Type:     schema.TypeList,
-																Required: true,
-																MinItems: 1,
-																MaxItems: 1,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"virtual_service_name": {
-																			Type:         schema.TypeString,
-																			Required:     true,
-																			ValidateFunc: validation.StringLenBetween(1, 255),
-																		},
-																	},
-																},
+															"name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 50),
 															},
 														},
 													},
 												},
-											},
-										},
-									},
 
-									"match": {
-										Type:     schema.TypeList,
-										Required: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"prefix": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+												"service_name": {
+													Type:     schema.TypeString,
+													Required: true,
 												},
 											},
 										},
@@ -265,6 +145,16 @@ func ResourceGatewayRoute() *schema.Resource {
 								"spec.0.http_route",
 							},
 						},
+
+						"http2_route": appmeshGatewayRouteHttpRouteSchema(),
+
+						"http_route": appmeshGatewayRouteHttpRouteSchema(),
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
 					},
 				},
 			},
@@ -298,6 +188,310 @@ func ResourceGatewayRoute() *schema.Resource {
 	}
 }
 
+// appmeshGatewayRouteHttpRouteSchema returns the schema for `http2_route` and `http_route` attributes.
+func appmeshGatewayRouteHttpRouteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 0,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"rewrite": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MinItems: 0,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"hostname": appmeshGatewayRouteHostnameRewriteSchema(),
+
+										"path": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MinItems: 0,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"exact": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 255),
+													},
+												},
+											},
+										},
+
+										"prefix": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MinItems: 0,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"default_prefix": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+													},
+
+													"value": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+
+							"target": appmeshGatewayRouteTargetSchema(),
+						},
+					},
+				},
+
+				"match": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"header": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								MinItems: 0,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"invert": {
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  false,
+										},
+
+										"match": appmeshGatewayRouteHeaderMatchMethodSchema(),
+
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 50),
+										},
+									},
+								},
+							},
+
+							"hostname": appmeshGatewayRouteHostnameMatchSchema(),
+
+							"path": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MinItems: 0,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exact": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+
+										"regex": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+								},
+							},
+
+							"prefix": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+							},
+
+							"query_parameter": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								MinItems: 0,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"match": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MinItems: 0,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"exact": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ExactlyOneOf: []string{
+			"spec.0.grpc_route",
+			"spec.0.http2_route",
+			"spec.0.http_route",
+		},
+	}
+}
+
+// appmeshGatewayRouteTargetSchema returns the schema for the route action `target` attribute.
+func appmeshGatewayRouteTargetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"virtual_service": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"virtual_service_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// appmeshGatewayRouteHostnameMatchSchema returns the schema for the route match `hostname` attribute.
+func appmeshGatewayRouteHostnameMatchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 0,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"exact": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 253),
+				},
+
+				"suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 253),
+				},
+			},
+		},
+	}
+}
+
+// appmeshGatewayRouteHostnameRewriteSchema returns the schema for the route rewrite `hostname` attribute.
+func appmeshGatewayRouteHostnameRewriteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 0,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_target_hostname": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+// appmeshGatewayRouteHeaderMatchMethodSchema returns the schema for header and metadata `match` attributes.
+func appmeshGatewayRouteHeaderMatchMethodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 0,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"exact": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+
+				"prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+
+				"range": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 0,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"end": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+
+							"start": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"regex": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+
+				"suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
 func resourceGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -454,12 +648,17 @@ func resourceGatewayRouteUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	log.Printf("[DEBUG] Deleting App Mesh gateway route (%s)", d.Id())
-	_, err := conn.DeleteGatewayRoute(&appmesh.DeleteGatewayRouteInput{
+	input := &appmesh.DeleteGatewayRouteInput{
 		GatewayRouteName:   aws.String(d.Get("name").(string)),
 		MeshName:           aws.String(d.Get("mesh_name").(string)),
 		VirtualGatewayName: aws.String(d.Get("virtual_gateway_name").(string)),
-	})
+	}
+	if v, ok := d.GetOk("mesh_owner"); ok {
+		input.MeshOwner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting App Mesh gateway route (%s)", d.Id())
+	_, err := conn.DeleteGatewayRoute(input)
 
 	if tfawserr.ErrMessageContains(err, appmesh.ErrCodeNotFoundException, "") {
 		return nil
@@ -520,6 +719,10 @@ func expandAppmeshGatewayRouteSpec(vSpec []interface{}) *appmesh.GatewayRouteSpe
 		spec.HttpRoute = expandAppmeshHttpGatewayRoute(vHttpRoute)
 	}
 
+	if vPriority, ok := mSpec["priority"].(int); ok && vPriority > 0 {
+		spec.Priority = aws.Int64(int64(vPriority))
+	}
+
 	return spec
 }
 
@@ -547,6 +750,62 @@ func expandAppmeshGatewayRouteTarget(vRouteTarget []interface{}) *appmesh.Gatewa
 	return routeTarget
 }
 
+func expandAppmeshGatewayRouteHostnameMatch(vHostnameMatch []interface{}) *appmesh.GatewayRouteHostnameMatch {
+	if len(vHostnameMatch) == 0 || vHostnameMatch[0] == nil {
+		return nil
+	}
+
+	hostnameMatch := &appmesh.GatewayRouteHostnameMatch{}
+
+	mHostnameMatch := vHostnameMatch[0].(map[string]interface{})
+
+	if vExact, ok := mHostnameMatch["exact"].(string); ok && vExact != "" {
+		hostnameMatch.Exact = aws.String(vExact)
+	}
+
+	if vSuffix, ok := mHostnameMatch["suffix"].(string); ok && vSuffix != "" {
+		hostnameMatch.Suffix = aws.String(vSuffix)
+	}
+
+	return hostnameMatch
+}
+
+func expandAppmeshGatewayRouteHostnameRewrite(vHostnameRewrite []interface{}) *appmesh.GatewayRouteHostnameRewrite {
+	if len(vHostnameRewrite) == 0 || vHostnameRewrite[0] == nil {
+		return nil
+	}
+
+	hostnameRewrite := &appmesh.GatewayRouteHostnameRewrite{}
+
+	mHostnameRewrite := vHostnameRewrite[0].(map[string]interface{})
+
+	if vDefaultTargetHostname, ok := mHostnameRewrite["default_target_hostname"].(string); ok && vDefaultTargetHostname != "" {
+		hostnameRewrite.DefaultTargetHostname = aws.String(vDefaultTargetHostname)
+	}
+
+	return hostnameRewrite
+}
+
+func expandAppmeshGatewayRouteMatchRange(vRange []interface{}) *appmesh.MatchRange {
+	if len(vRange) == 0 || vRange[0] == nil {
+		return nil
+	}
+
+	matchRange := &appmesh.MatchRange{}
+
+	mRange := vRange[0].(map[string]interface{})
+
+	if vEnd, ok := mRange["end"].(int); ok {
+		matchRange.End = aws.Int64(int64(vEnd))
+	}
+
+	if vStart, ok := mRange["start"].(int); ok {
+		matchRange.Start = aws.Int64(int64(vStart))
+	}
+
+	return matchRange
+}
+
 func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewayRoute {
 	if len(vGrpcRoute) == 0 || vGrpcRoute[0] == nil {
 		return nil
@@ -561,6 +820,18 @@ func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewa
 
 		mRouteAction := vRouteAction[0].(map[string]interface{})
 
+		if vRouteRewrite, ok := mRouteAction["rewrite"].([]interface{}); ok && len(vRouteRewrite) > 0 && vRouteRewrite[0] != nil {
+			routeRewrite := &appmesh.GrpcGatewayRouteRewrite{}
+
+			mRouteRewrite := vRouteRewrite[0].(map[string]interface{})
+
+			if vHostnameRewrite, ok := mRouteRewrite["hostname"].([]interface{}); ok {
+				routeRewrite.Hostname = expandAppmeshGatewayRouteHostnameRewrite(vHostnameRewrite)
+			}
+
+			routeAction.Rewrite = routeRewrite
+		}
+
 		if vRouteTarget, ok := mRouteAction["target"].([]interface{}); ok {
 			routeAction.Target = expandAppmeshGatewayRouteTarget(vRouteTarget)
 		}
@@ -573,6 +844,54 @@ func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewa
 
 		mRouteMatch := vRouteMatch[0].(map[string]interface{})
 
+		if vHostnameMatch, ok := mRouteMatch["hostname"].([]interface{}); ok {
+			routeMatch.Hostname = expandAppmeshGatewayRouteHostnameMatch(vHostnameMatch)
+		}
+
+		if vMetadata, ok := mRouteMatch["metadata"].(*schema.Set); ok && vMetadata.Len() > 0 {
+			metadata := []*appmesh.GrpcGatewayRouteMetadata{}
+
+			for _, vMetadatum := range vMetadata.List() {
+				metadatum := &appmesh.GrpcGatewayRouteMetadata{}
+
+				mMetadatum := vMetadatum.(map[string]interface{})
+
+				if vInvert, ok := mMetadatum["invert"].(bool); ok {
+					metadatum.Invert = aws.Bool(vInvert)
+				}
+
+				if vName, ok := mMetadatum["name"].(string); ok && vName != "" {
+					metadatum.Name = aws.String(vName)
+				}
+
+				if vMatch, ok := mMetadatum["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+					metadatum.Match = &appmesh.GrpcMetadataMatchMethod{}
+
+					mMatch := vMatch[0].(map[string]interface{})
+
+					if vExact, ok := mMatch["exact"].(string); ok && vExact != "" {
+						metadatum.Match.Exact = aws.String(vExact)
+					}
+					if vPrefix, ok := mMatch["prefix"].(string); ok && vPrefix != "" {
+						metadatum.Match.Prefix = aws.String(vPrefix)
+					}
+					if vRegex, ok := mMatch["regex"].(string); ok && vRegex != "" {
+						metadatum.Match.Regex = aws.String(vRegex)
+					}
+					if vSuffix, ok := mMatch["suffix"].(string); ok && vSuffix != "" {
+						metadatum.Match.Suffix = aws.String(vSuffix)
+					}
+					if vRange, ok := mMatch["range"].([]interface{}); ok {
+						metadatum.Match.Range = expandAppmeshGatewayRouteMatchRange(vRange)
+					}
+				}
+
+				metadata = append(metadata, metadatum)
+			}
+
+			routeMatch.Metadata = metadata
+		}
+
 		if vServiceName, ok := mRouteMatch["service_name"].(string); ok && vServiceName != "" {
 			routeMatch.ServiceName = aws.String(vServiceName)
 		}
@@ -597,6 +916,46 @@ func expandAppmeshHttpGatewayRoute(vHttpRoute []interface{}) *appmesh.HttpGatewa
 
 		mRouteAction := vRouteAction[0].(map[string]interface{})
 
+		if vRouteRewrite, ok := mRouteAction["rewrite"].([]interface{}); ok && len(vRouteRewrite) > 0 && vRouteRewrite[0] != nil {
+			routeRewrite := &appmesh.HttpGatewayRouteRewrite{}
+
+			mRouteRewrite := vRouteRewrite[0].(map[string]interface{})
+
+			if vHostnameRewrite, ok := mRouteRewrite["hostname"].([]interface{}); ok {
+				routeRewrite.Hostname = expandAppmeshGatewayRouteHostnameRewrite(vHostnameRewrite)
+			}
+
+			if vPathRewrite, ok := mRouteRewrite["path"].([]interface{}); ok && len(vPathRewrite) > 0 && vPathRewrite[0] != nil {
+				pathRewrite := &appmesh.HttpGatewayRoutePathRewrite{}
+
+				mPathRewrite := vPathRewrite[0].(map[string]interface{})
+
+				if vExact, ok := mPathRewrite["exact"].(string); ok && vExact != "" {
+					pathRewrite.Exact = aws.String(vExact)
+				}
+
+				routeRewrite.Path = pathRewrite
+			}
+
+			if vPrefixRewrite, ok := mRouteRewrite["prefix"].([]interface{}); ok && len(vPrefixRewrite) > 0 && vPrefixRewrite[0] != nil {
+				prefixRewrite := &appmesh.HttpGatewayRoutePrefixRewrite{}
+
+				mPrefixRewrite := vPrefixRewrite[0].(map[string]interface{})
+
+				if vDefaultPrefix, ok := mPrefixRewrite["default_prefix"].(string); ok && vDefaultPrefix != "" {
+					prefixRewrite.DefaultPrefix = aws.String(vDefaultPrefix)
+				}
+
+				if vValue, ok := mPrefixRewrite["value"].(string); ok && vValue != "" {
+					prefixRewrite.Value = aws.String(vValue)
+				}
+
+				routeRewrite.Prefix = prefixRewrite
+			}
+
+			routeAction.Rewrite = routeRewrite
+		}
+
 		if vRouteTarget, ok := mRouteAction["target"].([]interface{}); ok {
 			routeAction.Target = expandAppmeshGatewayRouteTarget(vRouteTarget)
 		}
@@ -609,10 +968,102 @@ func expandAppmeshHttpGatewayRoute(vHttpRoute []interface{}) *appmesh.HttpGatewa
 
 		mRouteMatch := vRouteMatch[0].(map[string]interface{})
 
+		if vHeaders, ok := mRouteMatch["header"].(*schema.Set); ok && vHeaders.Len() > 0 {
+			headers := []*appmesh.HttpGatewayRouteHeader{}
+
+			for _, vHeader := range vHeaders.List() {
+				header := &appmesh.HttpGatewayRouteHeader{}
+
+				mHeader := vHeader.(map[string]interface{})
+
+				if vInvert, ok := mHeader["invert"].(bool); ok {
+					header.Invert = aws.Bool(vInvert)
+				}
+
+				if vName, ok := mHeader["name"].(string); ok && vName != "" {
+					header.Name = aws.String(vName)
+				}
+
+				if vMatch, ok := mHeader["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+					header.Match = &appmesh.HeaderMatchMethod{}
+
+					mMatch := vMatch[0].(map[string]interface{})
+
+					if vExact, ok := mMatch["exact"].(string); ok && vExact != "" {
+						header.Match.Exact = aws.String(vExact)
+					}
+					if vPrefix, ok := mMatch["prefix"].(string); ok && vPrefix != "" {
+						header.Match.Prefix = aws.String(vPrefix)
+					}
+					if vRegex, ok := mMatch["regex"].(string); ok && vRegex != "" {
+						header.Match.Regex = aws.String(vRegex)
+					}
+					if vSuffix, ok := mMatch["suffix"].(string); ok && vSuffix != "" {
+						header.Match.Suffix = aws.String(vSuffix)
+					}
+					if vRange, ok := mMatch["range"].([]interface{}); ok {
+						header.Match.Range = expandAppmeshGatewayRouteMatchRange(vRange)
+					}
+				}
+
+				headers = append(headers, header)
+			}
+
+			routeMatch.Headers = headers
+		}
+
+		if vHostnameMatch, ok := mRouteMatch["hostname"].([]interface{}); ok {
+			routeMatch.Hostname = expandAppmeshGatewayRouteHostnameMatch(vHostnameMatch)
+		}
+
+		if vPathMatch, ok := mRouteMatch["path"].([]interface{}); ok && len(vPathMatch) > 0 && vPathMatch[0] != nil {
+			pathMatch := &appmesh.HttpPathMatch{}
+
+			mPathMatch := vPathMatch[0].(map[string]interface{})
+
+			if vExact, ok := mPathMatch["exact"].(string); ok && vExact != "" {
+				pathMatch.Exact = aws.String(vExact)
+			}
+
+			if vRegex, ok := mPathMatch["regex"].(string); ok && vRegex != "" {
+				pathMatch.Regex = aws.String(vRegex)
+			}
+
+			routeMatch.Path = pathMatch
+		}
+
 		if vPrefix, ok := mRouteMatch["prefix"].(string); ok && vPrefix != "" {
 			routeMatch.Prefix = aws.String(vPrefix)
 		}
 
+		if vQueryParameters, ok := mRouteMatch["query_parameter"].(*schema.Set); ok && vQueryParameters.Len() > 0 {
+			queryParameters := []*appmesh.HttpQueryParameter{}
+
+			for _, vQueryParameter := range vQueryParameters.List() {
+				queryParameter := &appmesh.HttpQueryParameter{}
+
+				mQueryParameter := vQueryParameter.(map[string]interface{})
+
+				if vName, ok := mQueryParameter["name"].(string); ok && vName != "" {
+					queryParameter.Name = aws.String(vName)
+				}
+
+				if vMatch, ok := mQueryParameter["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+					queryParameter.Match = &appmesh.QueryParameterMatch{}
+
+					mMatch := vMatch[0].(map[string]interface{})
+
+					if vExact, ok := mMatch["exact"].(string); ok && vExact != "" {
+						queryParameter.Match.Exact = aws.String(vExact)
+					}
+				}
+
+				queryParameters = append(queryParameters, queryParameter)
+			}
+
+			routeMatch.QueryParameters = queryParameters
+		}
+
 		route.Match = routeMatch
 	}
 
@@ -628,6 +1079,7 @@ func flattenAppmeshGatewayRouteSpec(spec *appmesh.GatewayRouteSpec) []interface{
 		"grpc_route":  flattenAppmeshGrpcGatewayRoute(spec.GrpcRoute),
 		"http2_route": flattenAppmeshHttpGatewayRoute(spec.Http2Route),
 		"http_route":  flattenAppmeshHttpGatewayRoute(spec.HttpRoute),
+		"priority":    int(aws.Int64Value(spec.Priority)),
 	}
 
 	return []interface{}{mSpec}
@@ -651,6 +1103,44 @@ func flattenAppmeshGatewayRouteTarget(routeTarget *appmesh.GatewayRouteTarget) [
 	return []interface{}{mRouteTarget}
 }
 
+func flattenAppmeshGatewayRouteHostnameMatch(hostnameMatch *appmesh.GatewayRouteHostnameMatch) []interface{} {
+	if hostnameMatch == nil {
+		return []interface{}{}
+	}
+
+	mHostnameMatch := map[string]interface{}{
+		"exact":  aws.StringValue(hostnameMatch.Exact),
+		"suffix": aws.StringValue(hostnameMatch.Suffix),
+	}
+
+	return []interface{}{mHostnameMatch}
+}
+
+func flattenAppmeshGatewayRouteHostnameRewrite(hostnameRewrite *appmesh.GatewayRouteHostnameRewrite) []interface{} {
+	if hostnameRewrite == nil {
+		return []interface{}{}
+	}
+
+	mHostnameRewrite := map[string]interface{}{
+		"default_target_hostname": aws.StringValue(hostnameRewrite.DefaultTargetHostname),
+	}
+
+	return []interface{}{mHostnameRewrite}
+}
+
+func flattenAppmeshGatewayRouteMatchRange(matchRange *appmesh.MatchRange) []interface{} {
+	if matchRange == nil {
+		return []interface{}{}
+	}
+
+	mRange := map[string]interface{}{
+		"end":   int(aws.Int64Value(matchRange.End)),
+		"start": int(aws.Int64Value(matchRange.Start)),
+	}
+
+	return []interface{}{mRange}
+}
+
 func flattenAppmeshGrpcGatewayRoute(grpcRoute *appmesh.GrpcGatewayRoute) []interface{} {
 	if grpcRoute == nil {
 		return []interface{}{}
@@ -663,14 +1153,48 @@ func flattenAppmeshGrpcGatewayRoute(grpcRoute *appmesh.GrpcGatewayRoute) []inter
 			"target": flattenAppmeshGatewayRouteTarget(routeAction.Target),
 		}
 
+		if routeRewrite := routeAction.Rewrite; routeRewrite != nil {
+			mRouteRewrite := map[string]interface{}{
+				"hostname": flattenAppmeshGatewayRouteHostnameRewrite(routeRewrite.Hostname),
+			}
+
+			mRouteAction["rewrite"] = []interface{}{mRouteRewrite}
+		}
+
 		mGrpcRoute["action"] = []interface{}{mRouteAction}
 	}
 
 	if routeMatch := grpcRoute.Match; routeMatch != nil {
 		mRouteMatch := map[string]interface{}{
+			"hostname":     flattenAppmeshGatewayRouteHostnameMatch(routeMatch.Hostname),
 			"service_name": aws.StringValue(routeMatch.ServiceName),
 		}
 
+		vMetadata := []interface{}{}
+
+		for _, metadatum := range routeMatch.Metadata {
+			mMetadatum := map[string]interface{}{
+				"invert": aws.BoolValue(metadatum.Invert),
+				"name":   aws.StringValue(metadatum.Name),
+			}
+
+			if match := metadatum.Match; match != nil {
+				mMatch := map[string]interface{}{
+					"exact":  aws.StringValue(match.Exact),
+					"prefix": aws.StringValue(match.Prefix),
+					"range":  flattenAppmeshGatewayRouteMatchRange(match.Range),
+					"regex":  aws.StringValue(match.Regex),
+					"suffix": aws.StringValue(match.Suffix),
+				}
+
+				mMetadatum["match"] = []interface{}{mMatch}
+			}
+
+			vMetadata = append(vMetadata, mMetadatum)
+		}
+
+		mRouteMatch["metadata"] = vMetadata
+
 		mGrpcRoute["match"] = []interface{}{mRouteMatch}
 	}
 
@@ -689,14 +1213,94 @@ func flattenAppmeshHttpGatewayRoute(httpRoute *appmesh.HttpGatewayRoute) []inter
 			"target": flattenAppmeshGatewayRouteTarget(routeAction.Target),
 		}
 
+		if routeRewrite := routeAction.Rewrite; routeRewrite != nil {
+			mRouteRewrite := map[string]interface{}{
+				"hostname": flattenAppmeshGatewayRouteHostnameRewrite(routeRewrite.Hostname),
+			}
+
+			if pathRewrite := routeRewrite.Path; pathRewrite != nil {
+				mRouteRewrite["path"] = []interface{}{
+					map[string]interface{}{
+						"exact": aws.StringValue(pathRewrite.Exact),
+					},
+				}
+			}
+
+			if prefixRewrite := routeRewrite.Prefix; prefixRewrite != nil {
+				mRouteRewrite["prefix"] = []interface{}{
+					map[string]interface{}{
+						"default_prefix": aws.StringValue(prefixRewrite.DefaultPrefix),
+						"value":          aws.StringValue(prefixRewrite.Value),
+					},
+				}
+			}
+
+			mRouteAction["rewrite"] = []interface{}{mRouteRewrite}
+		}
+
 		mHttpRoute["action"] = []interface{}{mRouteAction}
 	}
 
 	if routeMatch := httpRoute.Match; routeMatch != nil {
 		mRouteMatch := map[string]interface{}{
-			"prefix": aws.StringValue(routeMatch.Prefix),
+			"hostname": flattenAppmeshGatewayRouteHostnameMatch(routeMatch.Hostname),
+			"prefix":   aws.StringValue(routeMatch.Prefix),
 		}
 
+		vHeaders := []interface{}{}
+
+		for _, header := range routeMatch.Headers {
+			mHeader := map[string]interface{}{
+				"invert": aws.BoolValue(header.Invert),
+				"name":   aws.StringValue(header.Name),
+			}
+
+			if match := header.Match; match != nil {
+				mMatch := map[string]interface{}{
+					"exact":  aws.StringValue(match.Exact),
+					"prefix": aws.StringValue(match.Prefix),
+					"range":  flattenAppmeshGatewayRouteMatchRange(match.Range),
+					"regex":  aws.StringValue(match.Regex),
+					"suffix": aws.StringValue(match.Suffix),
+				}
+
+				mHeader["match"] = []interface{}{mMatch}
+			}
+
+			vHeaders = append(vHeaders, mHeader)
+		}
+
+		mRouteMatch["header"] = vHeaders
+
+		if pathMatch := routeMatch.Path; pathMatch != nil {
+			mRouteMatch["path"] = []interface{}{
+				map[string]interface{}{
+					"exact": aws.StringValue(pathMatch.Exact),
+					"regex": aws.StringValue(pathMatch.Regex),
+				},
+			}
+		}
+
+		vQueryParameters := []interface{}{}
+
+		for _, queryParameter := range routeMatch.QueryParameters {
+			mQueryParameter := map[string]interface{}{
+				"name": aws.StringValue(queryParameter.Name),
+			}
+
+			if match := queryParameter.Match; match != nil {
+				mQueryParameter["match"] = []interface{}{
+					map[string]interface{}{
+						"exact": aws.StringValue(match.Exact),
+					},
+				}
+			}
+
+			vQueryParameters = append(vQueryParameters, mQueryParameter)
+		}
+
+		mRouteMatch["query_parameter"] = vQueryParameters
+
 		mHttpRoute["match"] = []interface{}{mRouteMatch}
 	}
 
@@ -306,6 +306,106 @@ func testAccGatewayRoute_HTTP2Route(t *testing.T) {
 	})
 }
 
+func testAccGatewayRoute_HTTPRouteWithMatchAndRewrite(t *testing.T) {
+	var v appmesh.GatewayRouteData
+	resourceName := "aws_appmesh_gateway_route.test"
+	vsResourceName := "aws_appmesh_virtual_service.test.0"
+	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appmesh.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAppmeshGatewayRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshGatewayRouteConfigHttpRouteWithMatchAndRewrite(meshName, vgName, grName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshGatewayRouteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.hostname.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.hostname.0.default_target_hostname", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.prefix.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.prefix.0.value", "/v2/"),
+					resource.TestCheckResourceAttrPair(resourceName, "spec.0.http_route.0.action.0.target.0.virtual_service.0.virtual_service_name", vsResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.prefix", "/v1/"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.header.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "spec.0.http_route.0.match.0.header.*", map[string]string{
+						"invert":        "false",
+						"match.#":       "1",
+						"match.0.exact": "xyz",
+						"name":          "X-Testing1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.hostname.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.hostname.0.suffix", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.query_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "spec.0.http_route.0.match.0.query_parameter.*", map[string]string{
+						"match.#":       "1",
+						"match.0.exact": "xyz",
+						"name":          "param1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccGatewayRouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGatewayRoute_GRPCRouteWithMetadata(t *testing.T) {
+	var v appmesh.GatewayRouteData
+	resourceName := "aws_appmesh_gateway_route.test"
+	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appmesh.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAppmeshGatewayRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshGatewayRouteConfigGrpcRouteWithMetadata(meshName, vgName, grName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshGatewayRouteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.0.default_target_hostname", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.0.hostname.0.exact", "test.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.0.metadata.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "spec.0.grpc_route.0.match.0.metadata.*", map[string]string{
+						"invert":         "true",
+						"match.#":        "1",
+						"match.0.prefix": "abc",
+						"name":           "X-Testing1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.0.service_name", "test1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccGatewayRouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccGatewayRoute_Tags(t *testing.T) {
 	var v appmesh.GatewayRouteData
 	resourceName := "aws_appmesh_gateway_route.test"
@@ -597,6 +697,109 @@ resource "aws_appmesh_gateway_route" "test" {
 `, grName))
 }
 
+func testAccAppmeshGatewayRouteConfigHttpRouteWithMatchAndRewrite(meshName, vgName, grName string) string {
+	return acctest.ConfigCompose(testAccAppmeshGatewayRouteConfigBase(meshName, vgName), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    http_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+
+        rewrite {
+          hostname {
+            default_target_hostname = "DISABLED"
+          }
+
+          prefix {
+            value = "/v2/"
+          }
+        }
+      }
+
+      match {
+        prefix = "/v1/"
+
+        header {
+          name = "X-Testing1"
+
+          match {
+            exact = "xyz"
+          }
+        }
+
+        hostname {
+          suffix = "example.com"
+        }
+
+        query_parameter {
+          name = "param1"
+
+          match {
+            exact = "xyz"
+          }
+        }
+      }
+    }
+
+    priority = 10
+  }
+}
+`, grName))
+}
+
+func testAccAppmeshGatewayRouteConfigGrpcRouteWithMetadata(meshName, vgName, grName string) string {
+	return acctest.ConfigCompose(testAccAppmeshGatewayRouteConfigBase(meshName, vgName), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    grpc_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+
+        rewrite {
+          hostname {
+            default_target_hostname = "ENABLED"
+          }
+        }
+      }
+
+      match {
+        service_name = "test1"
+
+        hostname {
+          exact = "test.example.com"
+        }
+
+        metadata {
+          name   = "X-Testing1"
+          invert = true
+
+          match {
+            prefix = "abc"
+          }
+        }
+      }
+    }
+  }
+}
+`, grName))
+}
+
 func testAccAppmeshGatewayRouteConfigTags1(meshName, vgName, grName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAppmeshGatewayRouteConfigBase(meshName, vgName), fmt.Sprintf(`
 resource "aws_appmesh_gateway_route" "test" {
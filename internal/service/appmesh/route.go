@@ -859,12 +859,17 @@ func resourceRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	log.Printf("[DEBUG] Deleting App Mesh route: %s", d.Id())
-	_, err := conn.DeleteRoute(&appmesh.DeleteRouteInput{
+	input := &appmesh.DeleteRouteInput{
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
 		RouteName:         aws.String(d.Get("name").(string)),
 		VirtualRouterName: aws.String(d.Get("virtual_router_name").(string)),
-	})
+	}
+	if v, ok := d.GetOk("mesh_owner"); ok {
+		input.MeshOwner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting App Mesh route: %s", d.Id())
+	_, err := conn.DeleteRoute(input)
 	if tfawserr.ErrMessageContains(err, appmesh.ErrCodeNotFoundException, "") {
 		return nil
 	}
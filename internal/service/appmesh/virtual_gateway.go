@@ -839,11 +839,16 @@ func resourceVirtualGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceVirtualGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	log.Printf("[DEBUG] Deleting App Mesh virtual gateway (%s)", d.Id())
-	_, err := conn.DeleteVirtualGateway(&appmesh.DeleteVirtualGatewayInput{
+	input := &appmesh.DeleteVirtualGatewayInput{
 		MeshName:           aws.String(d.Get("mesh_name").(string)),
 		VirtualGatewayName: aws.String(d.Get("name").(string)),
-	})
+	}
+	if v, ok := d.GetOk("mesh_owner"); ok {
+		input.MeshOwner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting App Mesh virtual gateway (%s)", d.Id())
+	_, err := conn.DeleteVirtualGateway(input)
 
 	if tfawserr.ErrMessageContains(err, appmesh.ErrCodeNotFoundException, "") {
 		return nil
@@ -1214,11 +1214,16 @@ func resourceVirtualNodeUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceVirtualNodeDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	log.Printf("[DEBUG] Deleting App Mesh virtual node: %s", d.Id())
-	_, err := conn.DeleteVirtualNode(&appmesh.DeleteVirtualNodeInput{
+	input := &appmesh.DeleteVirtualNodeInput{
 		MeshName:        aws.String(d.Get("mesh_name").(string)),
 		VirtualNodeName: aws.String(d.Get("name").(string)),
-	})
+	}
+	if v, ok := d.GetOk("mesh_owner"); ok {
+		input.MeshOwner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting App Mesh virtual node: %s", d.Id())
+	_, err := conn.DeleteVirtualNode(input)
 
 	if tfawserr.ErrMessageContains(err, appmesh.ErrCodeNotFoundException, "") {
 		return nil
@@ -280,11 +280,16 @@ func resourceVirtualRouterUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceVirtualRouterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	log.Printf("[DEBUG] Deleting App Mesh virtual router: %s", d.Id())
-	_, err := conn.DeleteVirtualRouter(&appmesh.DeleteVirtualRouterInput{
+	input := &appmesh.DeleteVirtualRouterInput{
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
 		VirtualRouterName: aws.String(d.Get("name").(string)),
-	})
+	}
+	if v, ok := d.GetOk("mesh_owner"); ok {
+		input.MeshOwner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting App Mesh virtual router: %s", d.Id())
+	_, err := conn.DeleteVirtualRouter(input)
 	if tfawserr.ErrMessageContains(err, appmesh.ErrCodeNotFoundException, "") {
 		return nil
 	}
@@ -288,11 +288,16 @@ func resourceVirtualServiceUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceVirtualServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	log.Printf("[DEBUG] Deleting App Mesh virtual service: %s", d.Id())
-	_, err := conn.DeleteVirtualService(&appmesh.DeleteVirtualServiceInput{
+	input := &appmesh.DeleteVirtualServiceInput{
 		MeshName:           aws.String(d.Get("mesh_name").(string)),
 		VirtualServiceName: aws.String(d.Get("name").(string)),
-	})
+	}
+	if v, ok := d.GetOk("mesh_owner"); ok {
+		input.MeshOwner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting App Mesh virtual service: %s", d.Id())
+	_, err := conn.DeleteVirtualService(input)
 	if tfawserr.ErrMessageContains(err, appmesh.ErrCodeNotFoundException, "") {
 		return nil
 	}
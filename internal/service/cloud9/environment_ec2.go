@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -43,10 +44,22 @@ func ResourceEnvironmentEC2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"connection_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloud9.ConnectionTypeConnectSsh,
+				ValidateFunc: validation.StringInSlice(cloud9.ConnectionType_Values(), false),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"owner_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -81,6 +94,7 @@ func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) erro
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	params := &cloud9.CreateEnvironmentEC2Input{
+		ConnectionType:     aws.String(d.Get("connection_type").(string)),
 		InstanceType:       aws.String(d.Get("instance_type").(string)),
 		Name:               aws.String(d.Get("name").(string)),
 		ClientRequestToken: aws.String(resource.UniqueId()),
@@ -93,6 +107,9 @@ func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("description"); ok {
 		params.Description = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("image_id"); ok {
+		params.ImageId = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("owner_arn"); ok {
 		params.OwnerArn = aws.String(v.(string))
 	}
@@ -183,6 +200,7 @@ func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error
 
 	arn := aws.StringValue(env.Arn)
 	d.Set("arn", arn)
+	d.Set("connection_type", env.ConnectionType)
 	d.Set("description", env.Description)
 	d.Set("name", env.Name)
 	d.Set("owner_arn", env.OwnerArn)
@@ -33,6 +33,7 @@ func TestAccCloud9EnvironmentEC2_basic(t *testing.T) {
 				Config: testAccEnvironmentEC2Config(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEnvironmentEC2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", cloud9.ConnectionTypeConnectSsh),
 					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
@@ -109,6 +110,36 @@ func TestAccCloud9EnvironmentEC2_allFields(t *testing.T) {
 	})
 }
 
+func TestAccCloud9EnvironmentEC2_connectionTypeSSM(t *testing.T) {
+	var conf cloud9.Environment
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloud9_environment_ec2.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloud9.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentEC2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentEC2ConnectionTypeSSMConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentEC2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", cloud9.ConnectionTypeConnectSsm),
+					resource.TestCheckResourceAttr(resourceName, "image_id", "amazonlinux-2-x86_64"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_id", "instance_type", "subnet_id"},
+			},
+		},
+	})
+}
+
 func TestAccCloud9EnvironmentEC2_tags(t *testing.T) {
 	var conf cloud9.Environment
 
@@ -342,6 +373,20 @@ data "aws_caller_identity" "current" {}
 `, name)
 }
 
+func testAccEnvironmentEC2ConnectionTypeSSMConfig(name string) string {
+	return testAccEnvironmentEC2BaseConfig() + fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  depends_on = [aws_route.test]
+
+  connection_type = "CONNECT_SSM"
+  image_id        = "amazonlinux-2-x86_64"
+  instance_type   = "t2.micro"
+  name            = %[1]q
+  subnet_id       = aws_subnet.test.id
+}
+`, name)
+}
+
 func testAccEnvironmentEC2AllFieldsConfig(name, description, userName string) string {
 	return testAccEnvironmentEC2BaseConfig() + fmt.Sprintf(`
 resource "aws_cloud9_environment_ec2" "test" {
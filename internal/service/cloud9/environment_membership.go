@@ -0,0 +1,166 @@
+package cloud9
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironmentMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentMembershipCreate,
+		Read:   resourceEnvironmentMembershipRead,
+		Update: resourceEnvironmentMembershipUpdate,
+		Delete: resourceEnvironmentMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permissions": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloud9.MemberPermissions_Values(), false),
+			},
+			"user_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Cloud9Conn
+
+	environmentID := d.Get("environment_id").(string)
+	userARN := d.Get("user_arn").(string)
+	id := EnvironmentMembershipCreateResourceID(environmentID, userARN)
+	input := &cloud9.CreateEnvironmentMembershipInput{
+		EnvironmentId: aws.String(environmentID),
+		Permissions:   aws.String(d.Get("permissions").(string)),
+		UserArn:       aws.String(userARN),
+	}
+
+	log.Printf("[DEBUG] Creating Cloud9 Environment Membership: %s", input)
+	_, err := conn.CreateEnvironmentMembership(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cloud9 Environment Membership (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceEnvironmentMembershipRead(d, meta)
+}
+
+func resourceEnvironmentMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Cloud9Conn
+
+	environmentID, userARN, err := EnvironmentMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	membership, err := FindEnvironmentMembershipByTwoPartKey(conn, environmentID, userARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cloud9 Environment Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cloud9 Environment Membership (%s): %w", d.Id(), err)
+	}
+
+	// The environment owner is reported as a member with "owner" permissions, which cannot be managed as a membership.
+	if permissions := aws.StringValue(membership.Permissions); permissions == cloud9.PermissionsOwner {
+		if !d.IsNewResource() {
+			log.Printf("[WARN] Cloud9 Environment Membership (%s) is now the environment owner, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("error reading Cloud9 Environment Membership (%s): user is the environment owner", d.Id())
+	}
+
+	d.Set("environment_id", membership.EnvironmentId)
+	d.Set("permissions", membership.Permissions)
+	d.Set("user_arn", membership.UserArn)
+	d.Set("user_id", membership.UserId)
+
+	return nil
+}
+
+func resourceEnvironmentMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Cloud9Conn
+
+	environmentID, userARN, err := EnvironmentMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &cloud9.UpdateEnvironmentMembershipInput{
+		EnvironmentId: aws.String(environmentID),
+		Permissions:   aws.String(d.Get("permissions").(string)),
+		UserArn:       aws.String(userARN),
+	}
+
+	log.Printf("[DEBUG] Updating Cloud9 Environment Membership: %s", input)
+	_, err = conn.UpdateEnvironmentMembership(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Cloud9 Environment Membership (%s): %w", d.Id(), err)
+	}
+
+	return resourceEnvironmentMembershipRead(d, meta)
+}
+
+func resourceEnvironmentMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Cloud9Conn
+
+	environmentID, userARN, err := EnvironmentMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Cloud9 Environment Membership: %s", d.Id())
+	_, err = conn.DeleteEnvironmentMembership(&cloud9.DeleteEnvironmentMembershipInput{
+		EnvironmentId: aws.String(environmentID),
+		UserArn:       aws.String(userARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloud9.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cloud9 Environment Membership (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
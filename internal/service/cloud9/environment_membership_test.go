@@ -0,0 +1,152 @@
+package cloud9_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloud9 "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloud9EnvironmentMembership_basic(t *testing.T) {
+	var conf cloud9.EnvironmentMember
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloud9_environment_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloud9.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentMembershipConfig(rName, cloud9.MemberPermissionsReadOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentMembershipExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_cloud9_environment_ec2.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "permissions", cloud9.MemberPermissionsReadOnly),
+					resource.TestCheckResourceAttrPair(resourceName, "user_arn", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "aws_iam_user.test", "unique_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentMembershipConfig(rName, cloud9.MemberPermissionsReadWrite),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentMembershipExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions", cloud9.MemberPermissionsReadWrite),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloud9EnvironmentMembership_disappears(t *testing.T) {
+	var conf cloud9.EnvironmentMember
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloud9_environment_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloud9.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloud9.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentMembershipConfig(rName, cloud9.MemberPermissionsReadOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentMembershipExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloud9.ResourceEnvironmentMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentMembershipExists(n string, v *cloud9.EnvironmentMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud9 Environment Membership ID is set")
+		}
+
+		environmentID, userARN, err := tfcloud9.EnvironmentMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+
+		output, err := tfcloud9.FindEnvironmentMembershipByTwoPartKey(conn, environmentID, userARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloud9_environment_membership" {
+			continue
+		}
+
+		environmentID, userARN, err := tfcloud9.EnvironmentMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcloud9.FindEnvironmentMembershipByTwoPartKey(conn, environmentID, userARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cloud9 Environment Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEnvironmentMembershipConfig(rName, permissions string) string {
+	return acctest.ConfigCompose(testAccEnvironmentEC2Config(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloud9_environment_membership" "test" {
+  environment_id = aws_cloud9_environment_ec2.test.id
+  permissions    = %[2]q
+  user_arn       = aws_iam_user.test.arn
+}
+`, rName, permissions))
+}
@@ -0,0 +1,54 @@
+package cloud9
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEnvironmentMembershipByTwoPartKey(conn *cloud9.Cloud9, environmentID, userARN string) (*cloud9.EnvironmentMember, error) {
+	input := &cloud9.DescribeEnvironmentMembershipsInput{
+		EnvironmentId: aws.String(environmentID),
+		UserArn:       aws.String(userARN),
+	}
+	var result *cloud9.EnvironmentMember
+
+	err := conn.DescribeEnvironmentMembershipsPages(input, func(page *cloud9.DescribeEnvironmentMembershipsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, membership := range page.Memberships {
+			if membership == nil {
+				continue
+			}
+
+			if aws.StringValue(membership.UserArn) == userARN {
+				result = membership
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloud9.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package cloud9
+
+import (
+	"fmt"
+	"strings"
+)
+
+const environmentMembershipResourceIDSeparator = ","
+
+func EnvironmentMembershipCreateResourceID(environmentID, userARN string) string {
+	parts := []string{environmentID, userARN}
+	id := strings.Join(parts, environmentMembershipResourceIDSeparator)
+
+	return id
+}
+
+func EnvironmentMembershipParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, environmentMembershipResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ENVIRONMENT-ID%[2]sUSER-ARN", id, environmentMembershipResourceIDSeparator)
+}
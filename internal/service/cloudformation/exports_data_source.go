@@ -0,0 +1,72 @@
+package cloudformation
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceExports() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceExportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exporting_stack_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceExportsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+	region := meta.(*conns.AWSClient).Region
+
+	exportingStackIDs := make(map[string]string)
+	values := make(map[string]string)
+
+	input := &cloudformation.ListExportsInput{}
+	err := conn.ListExportsPages(input, func(page *cloudformation.ListExportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, e := range page.Exports {
+			if e == nil {
+				continue
+			}
+
+			name := aws.StringValue(e.Name)
+			exportingStackIDs[name] = aws.StringValue(e.ExportingStackId)
+			values[name] = aws.StringValue(e.Value)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudFormation exports: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("cloudformation-exports-%s", region))
+
+	if err := d.Set("exporting_stack_ids", exportingStackIDs); err != nil {
+		return fmt.Errorf("error setting exporting_stack_ids: %w", err)
+	}
+
+	if err := d.Set("values", values); err != nil {
+		return fmt.Errorf("error setting values: %w", err)
+	}
+
+	return nil
+}
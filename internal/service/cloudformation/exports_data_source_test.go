@@ -0,0 +1,72 @@
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFormationExportsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_exports.test"
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:                    testAccCheckExportsConfig(rName),
+				PreventPostDestroyRefresh: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, fmt.Sprintf("exporting_stack_ids.%s-first", rName), resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, fmt.Sprintf("exporting_stack_ids.%s-second", rName), resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("values.%s-first", rName), "first"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("values.%s-second", rName), "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExportsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = <<STACK
+{
+  "Resources": {
+    "waiter": {
+      "Type": "AWS::CloudFormation::WaitConditionHandle",
+      "Properties": { }
+    }
+  },
+  "Outputs": {
+    "first": {
+      "Value": "first",
+      "Export": {
+        "Name": "%[1]s-first"
+      }
+    },
+    "second": {
+      "Value": "second",
+      "Export": {
+        "Name": "%[1]s-second"
+      }
+    }
+  }
+}
+STACK
+}
+
+data "aws_cloudformation_exports" "test" {
+  depends_on = [aws_cloudformation_stack.test]
+}
+`, rName)
+}
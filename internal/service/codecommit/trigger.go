@@ -6,8 +6,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceTrigger() *schema.Resource {
@@ -40,9 +43,10 @@ func ResourceTrigger() *schema.Resource {
 						},
 
 						"destination_arn": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
 						},
 
 						"custom_data": {
@@ -62,7 +66,10 @@ func ResourceTrigger() *schema.Resource {
 							Type:     schema.TypeList,
 							Required: true,
 							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(codecommit.RepositoryTriggerEventEnum_Values(), false),
+							},
 						},
 					},
 				},
@@ -103,12 +110,32 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resp, err := conn.GetRepositoryTriggers(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, codecommit.ErrCodeRepositoryDoesNotExistException) {
+		log.Printf("[WARN] CodeCommit Repository (%s) not found, removing Trigger from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("Error reading CodeCommit Trigger: %s", err.Error())
 	}
 
 	log.Printf("[DEBUG] CodeCommit Trigger: %s", resp)
 
+	if !d.IsNewResource() && len(resp.Triggers) == 0 {
+		log.Printf("[WARN] CodeCommit Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("configuration_id", resp.ConfigurationId)
+	d.Set("repository_name", d.Id())
+
+	if err := d.Set("trigger", flattenTriggers(resp.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %w", err)
+	}
+
 	return nil
 }
 
@@ -158,3 +185,23 @@ func expandTriggers(configured []interface{}) []*codecommit.RepositoryTrigger {
 	}
 	return triggers
 }
+
+func flattenTriggers(triggers []*codecommit.RepositoryTrigger) []interface{} {
+	tfList := make([]interface{}, 0, len(triggers))
+
+	for _, t := range triggers {
+		if t == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"branches":        aws.StringValueSlice(t.Branches),
+			"custom_data":     aws.StringValue(t.CustomData),
+			"destination_arn": aws.StringValue(t.DestinationArn),
+			"events":          aws.StringValueSlice(t.Events),
+			"name":            aws.StringValue(t.Name),
+		})
+	}
+
+	return tfList
+}
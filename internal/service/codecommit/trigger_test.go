@@ -35,6 +35,45 @@ func TestAccCodeCommitTrigger_basic(t *testing.T) {
 	})
 }
 
+func TestAccCodeCommitTrigger_branches(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codecommit_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, codecommit.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCodeCommitTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeCommitTrigger_branches(rName, "main"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeCommitTriggerExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "trigger.*", map[string]string{
+						"branches.#": "1",
+						"branches.0": "main",
+						"events.#":   "2",
+						"events.0":   "createReference",
+						"events.1":   "deleteReference",
+					}),
+				),
+			},
+			{
+				Config: testAccCodeCommitTrigger_branches(rName, "develop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeCommitTriggerExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "trigger.*", map[string]string{
+						"branches.#": "1",
+						"branches.0": "develop",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCodeCommitTriggerDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn
 
@@ -108,3 +147,26 @@ resource "aws_codecommit_trigger" "test" {
 }
 `, rName)
 }
+
+func testAccCodeCommitTrigger_branches(rName, branch string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_codecommit_repository" "test" {
+  repository_name = %[1]q
+}
+
+resource "aws_codecommit_trigger" "test" {
+  repository_name = aws_codecommit_repository.test.id
+
+  trigger {
+    name            = %[1]q
+    events          = ["createReference", "deleteReference"]
+    destination_arn = aws_sns_topic.test.arn
+    branches        = [%[2]q]
+  }
+}
+`, rName, branch)
+}
@@ -1,6 +1,7 @@
 package codedeploy
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -136,7 +137,36 @@ func ResourceDeploymentConfig() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceDeploymentConfigCustomizeDiff,
+	}
+}
+
+// resourceDeploymentConfigCustomizeDiff enforces the compute platform specific
+// rules that CreateDeploymentConfig applies: EC2/on-premises configs are
+// defined by minimum healthy hosts, whereas Lambda and ECS configs are defined
+// by traffic routing.
+func resourceDeploymentConfigCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	computePlatform := diff.Get("compute_platform").(string)
+	_, hasMinimumHealthyHosts := diff.GetOk("minimum_healthy_hosts")
+	_, hasTrafficRoutingConfig := diff.GetOk("traffic_routing_config")
+
+	switch computePlatform {
+	case codedeploy.ComputePlatformServer:
+		if !hasMinimumHealthyHosts {
+			return fmt.Errorf("minimum_healthy_hosts is required when compute_platform is %q", computePlatform)
+		}
+
+		if hasTrafficRoutingConfig {
+			return fmt.Errorf("traffic_routing_config cannot be specified when compute_platform is %q", computePlatform)
+		}
+	case codedeploy.ComputePlatformLambda, codedeploy.ComputePlatformEcs:
+		if hasMinimumHealthyHosts {
+			return fmt.Errorf("minimum_healthy_hosts cannot be specified when compute_platform is %q", computePlatform)
+		}
 	}
+
+	return nil
 }
 
 func resourceDeploymentConfigCreate(d *schema.ResourceData, meta interface{}) error {
@@ -182,7 +212,14 @@ func resourceDeploymentConfigRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Cannot find DeploymentConfig %q", d.Id())
 	}
 
-	if err := d.Set("minimum_healthy_hosts", flattenMinimumHealthHostsConfig(resp.DeploymentConfigInfo.MinimumHealthyHosts)); err != nil {
+	// Minimum healthy hosts only apply to the EC2/on-premises compute platform.
+	minimumHealthyHosts := resp.DeploymentConfigInfo.MinimumHealthyHosts
+	switch aws.StringValue(resp.DeploymentConfigInfo.ComputePlatform) {
+	case codedeploy.ComputePlatformLambda, codedeploy.ComputePlatformEcs:
+		minimumHealthyHosts = nil
+	}
+
+	if err := d.Set("minimum_healthy_hosts", flattenMinimumHealthHostsConfig(minimumHealthyHosts)); err != nil {
 		return err
 	}
 
@@ -3,6 +3,7 @@ package codedeploy_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -228,6 +229,64 @@ func TestAccCodeDeployDeploymentConfig_trafficLinear(t *testing.T) {
 	})
 }
 
+func TestAccCodeDeployDeploymentConfig_trafficLinearECS(t *testing.T) {
+	var config1 codedeploy.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroyConfig,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentTrafficLinearECSConfig(rName, 5, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExistsConfig(resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "ECS"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedLinear"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeDeployDeploymentConfig_computePlatformValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroyConfig,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentLambdaMinimumHealthyHostsConfig(rName),
+				ExpectError: regexp.MustCompile(`minimum_healthy_hosts cannot be specified when compute_platform is "Lambda"`),
+			},
+			{
+				Config:      testAccDeploymentServerTrafficRoutingConfig(rName),
+				ExpectError: regexp.MustCompile(`traffic_routing_config cannot be specified when compute_platform is "Server"`),
+			},
+			{
+				Config:      testAccDeploymentServerNoMinimumHealthyHostsConfig(rName),
+				ExpectError: regexp.MustCompile(`minimum_healthy_hosts is required when compute_platform is "Server"`),
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentDestroyConfig(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeDeployConn
 
@@ -350,3 +409,65 @@ resource "aws_codedeploy_deployment_config" "test" {
 }
 `, rName, interval, percentage)
 }
+
+func testAccDeploymentTrafficLinearECSConfig(rName string, interval, percentage int) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %q
+  compute_platform       = "ECS"
+
+  traffic_routing_config {
+    type = "TimeBasedLinear"
+
+    time_based_linear {
+      interval   = %d
+      percentage = %d
+    }
+  }
+}
+`, rName, interval, percentage)
+}
+
+func testAccDeploymentLambdaMinimumHealthyHostsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %q
+  compute_platform       = "Lambda"
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+
+  traffic_routing_config {
+    type = "AllAtOnce"
+  }
+}
+`, rName)
+}
+
+func testAccDeploymentServerTrafficRoutingConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+
+  traffic_routing_config {
+    type = "AllAtOnce"
+  }
+}
+`, rName)
+}
+
+func testAccDeploymentServerNoMinimumHealthyHostsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %q
+  compute_platform       = "Server"
+}
+`, rName)
+}
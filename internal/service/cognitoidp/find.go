@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindCognitoUserPoolUICustomization returns the UI Customization corresponding to the UserPoolId and ClientId.
@@ -33,3 +36,41 @@ func FindCognitoUserPoolUICustomization(conn *cognitoidentityprovider.CognitoIde
 
 	return output.UICustomization, nil
 }
+
+// FindRiskConfigurationByTwoPartKey returns the risk configuration corresponding to the UserPoolId and ClientId.
+// Returns NotFoundError if no risk configuration is found.
+func FindRiskConfigurationByTwoPartKey(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, clientID string) (*cognitoidentityprovider.RiskConfigurationType, error) {
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	output, err := conn.DescribeRiskConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RiskConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	riskConfiguration := output.RiskConfiguration
+
+	// An unconfigured (or removed) risk configuration is returned with no settings.
+	if riskConfiguration.AccountTakeoverRiskConfiguration == nil && riskConfiguration.CompromisedCredentialsRiskConfiguration == nil && riskConfiguration.RiskExceptionConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return riskConfiguration, nil
+}
@@ -149,11 +149,32 @@ func resourceIdentityProviderRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("provider_type", ip.ProviderType)
 	d.Set("user_pool_id", ip.UserPoolId)
 
-	if err := d.Set("attribute_mapping", aws.StringValueMap(ip.AttributeMapping)); err != nil {
+	attributeMapping := aws.StringValueMap(ip.AttributeMapping)
+
+	// Cognito adds a "username" to "sub" mapping for social and OIDC providers.
+	// Only report it when there is no configured mapping or it is part of the configured mapping.
+	if v, ok := d.GetOk("attribute_mapping"); ok {
+		if _, ok := v.(map[string]interface{})["username"]; !ok && attributeMapping["username"] == "sub" {
+			delete(attributeMapping, "username")
+		}
+	}
+
+	if err := d.Set("attribute_mapping", attributeMapping); err != nil {
 		return fmt.Errorf("error setting attribute_mapping error: %w", err)
 	}
 
-	if err := d.Set("provider_details", aws.StringValueMap(ip.ProviderDetails)); err != nil {
+	providerDetails := aws.StringValueMap(ip.ProviderDetails)
+
+	// ActiveEncryptionCertificate is generated by Cognito for SAML providers.
+	delete(providerDetails, "ActiveEncryptionCertificate")
+
+	// When MetadataURL is used Cognito fetches the metadata document and returns it as MetadataFile.
+	// The two are mutually exclusive in requests and the document changes whenever the IdP refreshes it.
+	if _, ok := providerDetails["MetadataURL"]; ok {
+		delete(providerDetails, "MetadataFile")
+	}
+
+	if err := d.Set("provider_details", providerDetails); err != nil {
 		return fmt.Errorf("error setting provider_details error: %w", err)
 	}
 
@@ -112,6 +112,48 @@ func TestAccCognitoIDPIdentityProvider_idpIdentifiers(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPIdentityProvider_saml(t *testing.T) {
+	var identityProvider cognitoidentityprovider.IdentityProviderType
+	resourceName := "aws_cognito_identity_provider.test"
+	userPoolName := fmt.Sprintf("tf-acc-cognito-user-pool-%s", sdkacctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderSAMLConfig(userPoolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.email", "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.MetadataFile"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.SSORedirectBindingURI", "https://terraform-dev-ed.my.salesforce.com/idp/endpoint/HttpRedirect"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.IDPSignout", "false"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "Salesforce"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "SAML"),
+				),
+			},
+			{
+				Config: testAccIdentityProviderSAMLRequestSigningConfig(userPoolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.RequestSigningAlgorithm", "rsa-sha256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPIdentityProvider_disappears(t *testing.T) {
 	var identityProvider cognitoidentityprovider.IdentityProviderType
 	resourceName := "aws_cognito_identity_provider.test"
@@ -309,3 +351,54 @@ resource "aws_cognito_identity_provider" "test" {
 }
 `, userPoolName, attribute)
 }
+
+func testAccIdentityProviderSAMLConfig(userPoolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  provider_name = "Salesforce"
+  provider_type = "SAML"
+
+  provider_details = {
+    MetadataFile          = file("./test-fixtures/saml-metadata.xml")
+    SSORedirectBindingURI = "https://terraform-dev-ed.my.salesforce.com/idp/endpoint/HttpRedirect"
+    IDPSignout            = "false"
+  }
+
+  attribute_mapping = {
+    email = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+  }
+}
+`, userPoolName)
+}
+
+func testAccIdentityProviderSAMLRequestSigningConfig(userPoolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  provider_name = "Salesforce"
+  provider_type = "SAML"
+
+  provider_details = {
+    MetadataFile            = file("./test-fixtures/saml-metadata.xml")
+    SSORedirectBindingURI   = "https://terraform-dev-ed.my.salesforce.com/idp/endpoint/HttpRedirect"
+    IDPSignout              = "false"
+    RequestSigningAlgorithm = "rsa-sha256"
+  }
+
+  attribute_mapping = {
+    email = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+  }
+}
+`, userPoolName)
+}
@@ -0,0 +1,677 @@
+package cognitoidp
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRiskConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRiskConfigurationPut,
+		Read:   resourceRiskConfigurationRead,
+		Update: resourceRiskConfigurationPut,
+		Delete: resourceRiskConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_takeover_risk_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"high_action":   accountTakeoverActionSchema(),
+									"low_action":    accountTakeoverActionSchema(),
+									"medium_action": accountTakeoverActionSchema(),
+								},
+							},
+						},
+						"notify_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_email": notifyEmailSchema(),
+									"from": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"mfa_email":       notifyEmailSchema(),
+									"no_action_email": notifyEmailSchema(),
+									"reply_to": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+				AtLeastOneOf: []string{"account_takeover_risk_configuration", "compromised_credentials_risk_configuration", "risk_exception_configuration"},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"compromised_credentials_risk_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_action": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CompromisedCredentialsEventActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"event_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cognitoidentityprovider.EventFilterType_Values(), false),
+							},
+						},
+					},
+				},
+				AtLeastOneOf: []string{"account_takeover_risk_configuration", "compromised_credentials_risk_configuration", "risk_exception_configuration"},
+			},
+			"risk_exception_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blocked_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+							AtLeastOneOf: []string{"risk_exception_configuration.0.blocked_ip_range_list", "risk_exception_configuration.0.skipped_ip_range_list"},
+						},
+						"skipped_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+							AtLeastOneOf: []string{"risk_exception_configuration.0.blocked_ip_range_list", "risk_exception_configuration.0.skipped_ip_range_list"},
+						},
+					},
+				},
+				AtLeastOneOf: []string{"account_takeover_risk_configuration", "compromised_credentials_risk_configuration", "risk_exception_configuration"},
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func accountTakeoverActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"event_action": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(cognitoidentityprovider.AccountTakeoverEventActionType_Values(), false),
+				},
+				"notify": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func notifyEmailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"html_body": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+				"subject": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 140),
+				},
+				"text_body": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+			},
+		},
+	}
+}
+
+func resourceRiskConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	id := userPoolID
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("client_id"); ok {
+		clientID := v.(string)
+		id = RiskConfigurationCreateResourceID(userPoolID, clientID)
+		input.ClientId = aws.String(clientID)
+	}
+
+	if v, ok := d.GetOk("account_takeover_risk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccountTakeoverRiskConfiguration = expandAccountTakeoverRiskConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("compromised_credentials_risk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CompromisedCredentialsRiskConfiguration = expandCompromisedCredentialsRiskConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("risk_exception_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RiskExceptionConfiguration = expandRiskExceptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Setting Cognito Risk Configuration: %s", input)
+	_, err := conn.SetRiskConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito Risk Configuration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRiskConfigurationRead(d, meta)
+}
+
+func resourceRiskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	userPoolID, clientID, err := RiskConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	riskConfiguration, err := FindRiskConfigurationByTwoPartKey(conn, userPoolID, clientID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Risk Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito Risk Configuration (%s): %w", d.Id(), err)
+	}
+
+	if riskConfiguration.AccountTakeoverRiskConfiguration != nil {
+		if err := d.Set("account_takeover_risk_configuration", []interface{}{flattenAccountTakeoverRiskConfiguration(riskConfiguration.AccountTakeoverRiskConfiguration)}); err != nil {
+			return fmt.Errorf("error setting account_takeover_risk_configuration: %w", err)
+		}
+	} else {
+		d.Set("account_takeover_risk_configuration", nil)
+	}
+
+	d.Set("client_id", clientID)
+
+	if riskConfiguration.CompromisedCredentialsRiskConfiguration != nil {
+		if err := d.Set("compromised_credentials_risk_configuration", []interface{}{flattenCompromisedCredentialsRiskConfiguration(riskConfiguration.CompromisedCredentialsRiskConfiguration)}); err != nil {
+			return fmt.Errorf("error setting compromised_credentials_risk_configuration: %w", err)
+		}
+	} else {
+		d.Set("compromised_credentials_risk_configuration", nil)
+	}
+
+	if riskConfiguration.RiskExceptionConfiguration != nil {
+		if err := d.Set("risk_exception_configuration", []interface{}{flattenRiskExceptionConfiguration(riskConfiguration.RiskExceptionConfiguration)}); err != nil {
+			return fmt.Errorf("error setting risk_exception_configuration: %w", err)
+		}
+	} else {
+		d.Set("risk_exception_configuration", nil)
+	}
+
+	d.Set("user_pool_id", userPoolID)
+
+	return nil
+}
+
+func resourceRiskConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	userPoolID, clientID, err := RiskConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Setting the risk configuration without any settings removes it.
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Risk Configuration: %s", d.Id())
+	_, err = conn.SetRiskConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito Risk Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const riskConfigurationResourceIDSeparator = ","
+
+func RiskConfigurationCreateResourceID(userPoolID, clientID string) string {
+	parts := []string{userPoolID, clientID}
+	id := strings.Join(parts, riskConfigurationResourceIDSeparator)
+
+	return id
+}
+
+// RiskConfigurationParseResourceID parses an ID of the form USER_POOL_ID or USER_POOL_ID,CLIENT_ID.
+// The client ID is empty for a user pool level risk configuration.
+func RiskConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, riskConfigurationResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USER-POOL-ID or USER-POOL-ID%[2]sCLIENT-ID", id, riskConfigurationResourceIDSeparator)
+}
+
+func expandAccountTakeoverRiskConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverRiskConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverRiskConfigurationType{}
+
+	if v, ok := tfMap["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Actions = expandAccountTakeoverActions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["notify_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NotifyConfiguration = expandNotifyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAccountTakeoverActions(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverActionsType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverActionsType{}
+
+	if v, ok := tfMap["high_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HighAction = expandAccountTakeoverAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["low_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LowAction = expandAccountTakeoverAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["medium_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MediumAction = expandAccountTakeoverAction(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAccountTakeoverAction(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverActionType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverActionType{}
+
+	if v, ok := tfMap["event_action"].(string); ok && v != "" {
+		apiObject.EventAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["notify"].(bool); ok {
+		apiObject.Notify = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandNotifyConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.NotifyConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.NotifyConfigurationType{}
+
+	if v, ok := tfMap["block_email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BlockEmail = expandNotifyEmail(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["from"].(string); ok && v != "" {
+		apiObject.From = aws.String(v)
+	}
+
+	if v, ok := tfMap["mfa_email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MfaEmail = expandNotifyEmail(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["no_action_email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NoActionEmail = expandNotifyEmail(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["reply_to"].(string); ok && v != "" {
+		apiObject.ReplyTo = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_arn"].(string); ok && v != "" {
+		apiObject.SourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNotifyEmail(tfMap map[string]interface{}) *cognitoidentityprovider.NotifyEmailType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.NotifyEmailType{}
+
+	if v, ok := tfMap["html_body"].(string); ok && v != "" {
+		apiObject.HtmlBody = aws.String(v)
+	}
+
+	if v, ok := tfMap["subject"].(string); ok && v != "" {
+		apiObject.Subject = aws.String(v)
+	}
+
+	if v, ok := tfMap["text_body"].(string); ok && v != "" {
+		apiObject.TextBody = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCompromisedCredentialsRiskConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType{}
+
+	if v, ok := tfMap["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Actions = expandCompromisedCredentialsActions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["event_filter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EventFilter = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCompromisedCredentialsActions(tfMap map[string]interface{}) *cognitoidentityprovider.CompromisedCredentialsActionsType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.CompromisedCredentialsActionsType{}
+
+	if v, ok := tfMap["event_action"].(string); ok && v != "" {
+		apiObject.EventAction = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRiskExceptionConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.RiskExceptionConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.RiskExceptionConfigurationType{}
+
+	if v, ok := tfMap["blocked_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.BlockedIPRangeList = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["skipped_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SkippedIPRangeList = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccountTakeoverRiskConfiguration(apiObject *cognitoidentityprovider.AccountTakeoverRiskConfigurationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["actions"] = []interface{}{flattenAccountTakeoverActions(v)}
+	}
+
+	if v := apiObject.NotifyConfiguration; v != nil {
+		tfMap["notify_configuration"] = []interface{}{flattenNotifyConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAccountTakeoverActions(apiObject *cognitoidentityprovider.AccountTakeoverActionsType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HighAction; v != nil {
+		tfMap["high_action"] = []interface{}{flattenAccountTakeoverAction(v)}
+	}
+
+	if v := apiObject.LowAction; v != nil {
+		tfMap["low_action"] = []interface{}{flattenAccountTakeoverAction(v)}
+	}
+
+	if v := apiObject.MediumAction; v != nil {
+		tfMap["medium_action"] = []interface{}{flattenAccountTakeoverAction(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAccountTakeoverAction(apiObject *cognitoidentityprovider.AccountTakeoverActionType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EventAction; v != nil {
+		tfMap["event_action"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Notify; v != nil {
+		tfMap["notify"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenNotifyConfiguration(apiObject *cognitoidentityprovider.NotifyConfigurationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BlockEmail; v != nil {
+		tfMap["block_email"] = []interface{}{flattenNotifyEmail(v)}
+	}
+
+	if v := apiObject.From; v != nil {
+		tfMap["from"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MfaEmail; v != nil {
+		tfMap["mfa_email"] = []interface{}{flattenNotifyEmail(v)}
+	}
+
+	if v := apiObject.NoActionEmail; v != nil {
+		tfMap["no_action_email"] = []interface{}{flattenNotifyEmail(v)}
+	}
+
+	if v := apiObject.ReplyTo; v != nil {
+		tfMap["reply_to"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SourceArn; v != nil {
+		tfMap["source_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenNotifyEmail(apiObject *cognitoidentityprovider.NotifyEmailType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HtmlBody; v != nil {
+		tfMap["html_body"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Subject; v != nil {
+		tfMap["subject"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TextBody; v != nil {
+		tfMap["text_body"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCompromisedCredentialsRiskConfiguration(apiObject *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["actions"] = []interface{}{flattenCompromisedCredentialsActions(v)}
+	}
+
+	if v := apiObject.EventFilter; v != nil {
+		tfMap["event_filter"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCompromisedCredentialsActions(apiObject *cognitoidentityprovider.CompromisedCredentialsActionsType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EventAction; v != nil {
+		tfMap["event_action"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRiskExceptionConfiguration(apiObject *cognitoidentityprovider.RiskExceptionConfigurationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BlockedIPRangeList; v != nil {
+		tfMap["blocked_ip_range_list"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SkippedIPRangeList; v != nil {
+		tfMap["skipped_ip_range_list"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
@@ -1,6 +1,7 @@
 package cognitoidp
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -18,6 +20,7 @@ func ResourceUserPoolDomain() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserPoolDomainCreate,
 		Read:   resourceUserPoolDomainRead,
+		Update: resourceUserPoolDomainUpdate,
 		Delete: resourceUserPoolDomainDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -33,7 +36,6 @@ func ResourceUserPoolDomain() *schema.Resource {
 			"certificate_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"user_pool_id": {
@@ -58,6 +60,12 @@ func ResourceUserPoolDomain() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		// The certificate of a custom domain can be rotated in place, but a
+		// prefix domain cannot be converted to a custom domain or vice versa.
+		CustomizeDiff: customdiff.ForceNewIfChange("certificate_arn", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(string) == "" || new.(string) == ""
+		}),
 	}
 }
 
@@ -135,6 +143,33 @@ func resourceUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceUserPoolDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	if d.HasChange("certificate_arn") {
+		input := &cognitoidentityprovider.UpdateUserPoolDomainInput{
+			CustomDomainConfig: &cognitoidentityprovider.CustomDomainConfigType{
+				CertificateArn: aws.String(d.Get("certificate_arn").(string)),
+			},
+			Domain:     aws.String(d.Id()),
+			UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Cognito User Pool Domain: %s", input)
+		_, err := conn.UpdateUserPoolDomain(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Cognito User Pool Domain (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitUserPoolDomainUpdated(conn, d.Id(), userPoolDomainUpdateTimeout); err != nil {
+			return fmt.Errorf("error waiting for User Pool Domain (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceUserPoolDomainRead(d, meta)
+}
+
 func resourceUserPoolDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CognitoIDPConn
 	log.Printf("[DEBUG] Deleting Cognito User Pool Domain: %s", d.Id())
@@ -10,6 +10,9 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Success
 	userPoolDomainDeleteTimeout = 1 * time.Minute
+
+	// Custom domains are updated through CloudFront
+	userPoolDomainUpdateTimeout = 60 * time.Minute
 )
 
 // waitUserPoolDomainDeleted waits for an Operation to return Success
@@ -54,3 +57,24 @@ func waitUserPoolDomainCreated(conn *cognitoidentityprovider.CognitoIdentityProv
 
 	return nil, err
 }
+
+func waitUserPoolDomainUpdated(conn *cognitoidentityprovider.CognitoIdentityProvider, domain string, timeout time.Duration) (*cognitoidentityprovider.DescribeUserPoolDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cognitoidentityprovider.DomainStatusTypeUpdating,
+		},
+		Target: []string{
+			cognitoidentityprovider.DomainStatusTypeActive,
+		},
+		Refresh: statusUserPoolDomain(conn, domain),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cognitoidentityprovider.DescribeUserPoolDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -40,6 +41,12 @@ func ResourceConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validConnectionBandWidth(),
 			},
+			"encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(EncryptionMode_Values(), false),
+			},
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -53,6 +60,10 @@ func ResourceConnection() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"macsec_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -62,12 +73,22 @@ func ResourceConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"port_encryption_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"provider_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			"request_macsec": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -92,6 +113,10 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 		input.ProviderName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("request_macsec"); ok {
+		input.RequestMACSec = aws.Bool(v.(bool))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -105,6 +130,13 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.ConnectionId))
 
+	// The encryption mode can only be set after the connection has been created.
+	if v, ok := d.GetOk("encryption_mode"); ok {
+		if err := updateConnectionEncryptionMode(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceConnectionRead(d, meta)
 }
 
@@ -135,11 +167,18 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", arn)
 	d.Set("aws_device", connection.AwsDeviceV2)
 	d.Set("bandwidth", connection.Bandwidth)
+	d.Set("encryption_mode", connection.EncryptionMode)
 	d.Set("has_logical_redundancy", connection.HasLogicalRedundancy)
 	d.Set("jumbo_frame_capable", connection.JumboFrameCapable)
 	d.Set("location", connection.Location)
+	d.Set("macsec_capable", connection.MacSecCapable)
+	// request_macsec is not returned by the API. Derive it when there is no prior value, e.g. on import.
+	if _, ok := d.GetOkExists("request_macsec"); !ok {
+		d.Set("request_macsec", connection.MacSecCapable)
+	}
 	d.Set("name", connection.ConnectionName)
 	d.Set("owner_account_id", connection.OwnerAccount)
+	d.Set("port_encryption_status", connection.PortEncryptionStatus)
 	d.Set("provider_name", connection.ProviderName)
 
 	tags, err := ListTags(conn, arn)
@@ -165,6 +204,12 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 func resourceConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
+	if d.HasChange("encryption_mode") {
+		if err := updateConnectionEncryptionMode(conn, d.Id(), d.Get("encryption_mode").(string)); err != nil {
+			return err
+		}
+	}
+
 	arn := d.Get("arn").(string)
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -183,6 +228,22 @@ func resourceConnectionDelete(d *schema.ResourceData, meta interface{}) error {
 	return deleteDirectConnectConnection(conn, d.Id(), waitConnectionDeleted)
 }
 
+func updateConnectionEncryptionMode(conn *directconnect.DirectConnect, connectionID, encryptionMode string) error {
+	input := &directconnect.UpdateConnectionInput{
+		ConnectionId:   aws.String(connectionID),
+		EncryptionMode: aws.String(encryptionMode),
+	}
+
+	log.Printf("[DEBUG] Updating Direct Connect Connection: %s", input)
+	_, err := conn.UpdateConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Direct Connect Connection (%s) encryption mode: %w", connectionID, err)
+	}
+
+	return nil
+}
+
 func deleteDirectConnectConnection(conn *directconnect.DirectConnect, connectionID string, waiter func(*directconnect.DirectConnect, string) (*directconnect.Connection, error)) error {
 	log.Printf("[DEBUG] Deleting Direct Connect Connection: %s", connectionID)
 	_, err := conn.DeleteConnection(&directconnect.DeleteConnectionInput{
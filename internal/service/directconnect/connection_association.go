@@ -52,7 +52,11 @@ func resourceConnectionAssociationCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(aws.StringValue(output.ConnectionId))
 
-	return nil
+	if _, err := waitConnectionAssociated(conn, d.Id(), lagID); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Connection (%s) LAG (%s) Association create: %w", d.Id(), lagID, err)
+	}
+
+	return resourceConnectionAssociationRead(d, meta)
 }
 
 func resourceConnectionAssociationRead(d *schema.ResourceData, meta interface{}) error {
@@ -93,6 +97,7 @@ func deleteDirectConnectConnectionLAGAssociation(conn *directconnect.DirectConne
 		},
 		func(err error) (bool, error) {
 			if tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "Connection does not exist") ||
+				tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "Could not find Lag with ID") ||
 				tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "Lag does not exist") {
 				return false, nil
 			}
@@ -101,6 +106,19 @@ func deleteDirectConnectConnectionLAGAssociation(conn *directconnect.DirectConne
 				return true, err
 			}
 
+			// Connections that are still being provisioned cannot be disassociated.
+			if tfawserr.ErrCodeEquals(err, directconnect.ErrCodeClientException) {
+				connection, findErr := FindConnectionByID(conn, connectionID)
+
+				if tfresource.NotFound(findErr) {
+					return false, nil
+				}
+
+				if findErr == nil && aws.StringValue(connection.ConnectionState) == directconnect.ConnectionStateOrdering {
+					return true, err
+				}
+			}
+
 			return false, err
 		},
 	)
@@ -36,6 +36,7 @@ func TestAccDirectConnectConnection_basic(t *testing.T) {
 					acctest.CheckResourceAttrAccountID(resourceName, "owner_account_id"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "provider_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "request_macsec", "false"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -49,6 +50,36 @@ func TestAccDirectConnectConnection_basic(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectConnection_requestMACSec(t *testing.T) {
+	var connection directconnect.Connection
+	resourceName := "aws_dx_connection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, directconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxConnectionConfigRequestMACSec(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "10Gbps"),
+					resource.TestCheckResourceAttr(resourceName, "macsec_capable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "request_macsec", "true"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectConnection_disappears(t *testing.T) {
 	var connection directconnect.Connection
 	resourceName := "aws_dx_connection.test"
@@ -221,6 +252,29 @@ resource "aws_dx_connection" "test" {
 `, rName)
 }
 
+func testAccDxConnectionConfigRequestMACSec(rName string) string {
+	return fmt.Sprintf(`
+data "aws_dx_locations" "test" {}
+
+data "aws_dx_location" "test" {
+  for_each = data.aws_dx_locations.test.location_codes
+
+  location_code = each.value
+}
+
+locals {
+  macsec_location_codes = [for k, v in data.aws_dx_location.test : k if contains(v.available_macsec_port_speeds, "10Gbps")]
+}
+
+resource "aws_dx_connection" "test" {
+  name           = %[1]q
+  bandwidth      = "10Gbps"
+  location       = local.macsec_location_codes[0]
+  request_macsec = true
+}
+`, rName)
+}
+
 func testAccDxConnectionConfigProviderName(rName string) string {
 	return fmt.Sprintf(`
 data "aws_dx_locations" "test" {}
@@ -0,0 +1,22 @@
+package directconnect
+
+const (
+	EncryptionModeMustEncrypt   = "must_encrypt"
+	EncryptionModeNoEncrypt     = "no_encrypt"
+	EncryptionModeShouldEncrypt = "should_encrypt"
+)
+
+func EncryptionMode_Values() []string {
+	return []string{
+		EncryptionModeMustEncrypt,
+		EncryptionModeNoEncrypt,
+		EncryptionModeShouldEncrypt,
+	}
+}
+
+const (
+	MacSecKeyStateAssociating    = "associating"
+	MacSecKeyStateAssociated     = "associated"
+	MacSecKeyStateDisassociating = "disassociating"
+	MacSecKeyStateDisassociated  = "disassociated"
+)
@@ -1,6 +1,9 @@
 package directconnect
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -53,8 +56,10 @@ func FindConnectionAssociationExists(conn *directconnect.DirectConnect, connecti
 		return err
 	}
 
-	if lagID != aws.StringValue(connection.LagId) {
-		return &resource.NotFoundError{}
+	if v := aws.StringValue(connection.LagId); v != lagID {
+		return &resource.NotFoundError{
+			Message: fmt.Sprintf("Direct Connect Connection (%s) is associated with LAG (%s)", connectionID, v),
+		}
 	}
 
 	return nil
@@ -296,3 +301,46 @@ func FindLocations(conn *directconnect.DirectConnect, input *directconnect.Descr
 
 	return output.Locations, nil
 }
+
+func FindMacSecKeyByConnectionIDAndSecretARN(conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	var macSecKeys []*directconnect.MacSecKey
+
+	// MACsec keys can be associated with either a dedicated connection or a LAG.
+	if strings.HasPrefix(connectionID, "dxlag-") {
+		lag, err := FindLagByID(conn, connectionID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		macSecKeys = lag.MacSecKeys
+	} else {
+		connection, err := FindConnectionByID(conn, connectionID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		macSecKeys = connection.MacSecKeys
+	}
+
+	for _, macSecKey := range macSecKeys {
+		if macSecKey == nil {
+			continue
+		}
+
+		if aws.StringValue(macSecKey.SecretARN) != secretARN {
+			continue
+		}
+
+		if state := aws.StringValue(macSecKey.State); state == MacSecKeyStateDisassociated {
+			return nil, &resource.NotFoundError{
+				Message: state,
+			}
+		}
+
+		return macSecKey, nil
+	}
+
+	return nil, &resource.NotFoundError{}
+}
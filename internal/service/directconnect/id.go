@@ -2,8 +2,29 @@ package directconnect
 
 import (
 	"fmt"
+	"strings"
 )
 
 func GatewayAssociationCreateResourceID(directConnectGatewayID, associatedGatewayID string) string {
 	return fmt.Sprintf("ga-%s%s", directConnectGatewayID, associatedGatewayID)
 }
+
+const macSecKeyAssociationResourceIDSeparator = "_"
+
+func MacSecKeyAssociationCreateResourceID(secretARN, connectionID string) string {
+	parts := []string{secretARN, connectionID}
+	id := strings.Join(parts, macSecKeyAssociationResourceIDSeparator)
+
+	return id
+}
+
+func MacSecKeyAssociationParseResourceID(id string) (string, string, error) {
+	// The secret ARN may contain the separator but the connection ID never does.
+	idx := strings.LastIndex(id, macSecKeyAssociationResourceIDSeparator)
+
+	if idx <= 0 || idx == len(id)-1 {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SECRET-ARN%[2]sCONNECTION-ID", id, macSecKeyAssociationResourceIDSeparator)
+	}
+
+	return id[:idx], id[idx+1:], nil
+}
@@ -0,0 +1,67 @@
+package directconnect_test
+
+import (
+	"testing"
+
+	tfdirectconnect "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+)
+
+func TestMacSecKeyAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectError          bool
+		ExpectedSecretARN    string
+		ExpectedConnectionID string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing connection ID",
+			InputID:     "arn:aws:secretsmanager:us-east-1:123456789012:secret:test_", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfdirectconnect.MacSecKeyAssociationCreateResourceID("arn:aws:secretsmanager:us-east-1:123456789012:secret:test", "dxcon-fg5678gh"), //lintignore:AWSAT003,AWSAT005
+			ExpectedSecretARN:    "arn:aws:secretsmanager:us-east-1:123456789012:secret:test",                                                                         //lintignore:AWSAT003,AWSAT005
+			ExpectedConnectionID: "dxcon-fg5678gh",
+		},
+		{
+			TestName:             "valid ID underscore in secret name",
+			InputID:              tfdirectconnect.MacSecKeyAssociationCreateResourceID("arn:aws:secretsmanager:us-east-1:123456789012:secret:my_test", "dxlag-fgr4lfqb"), //lintignore:AWSAT003,AWSAT005
+			ExpectedSecretARN:    "arn:aws:secretsmanager:us-east-1:123456789012:secret:my_test",                                                                         //lintignore:AWSAT003,AWSAT005
+			ExpectedConnectionID: "dxlag-fgr4lfqb",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotSecretARN, gotConnectionID, err := tfdirectconnect.MacSecKeyAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotSecretARN != testCase.ExpectedSecretARN {
+				t.Errorf("got SecretARN %s, expected %s", gotSecretARN, testCase.ExpectedSecretARN)
+			}
+
+			if gotConnectionID != testCase.ExpectedConnectionID {
+				t.Errorf("got ConnectionID %s, expected %s", gotConnectionID, testCase.ExpectedConnectionID)
+			}
+		})
+	}
+}
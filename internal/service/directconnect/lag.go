@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -41,6 +42,12 @@ func ResourceLag() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validConnectionBandWidth(),
 			},
+			"encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(EncryptionMode_Values(), false),
+			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -59,6 +66,10 @@ func ResourceLag() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"macsec_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -73,6 +84,12 @@ func ResourceLag() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"request_macsec": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -106,6 +123,10 @@ func resourceLagCreate(d *schema.ResourceData, meta interface{}) error {
 		input.ProviderName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("request_macsec"); ok {
+		input.RequestMACSec = aws.Bool(v.(bool))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -128,6 +149,19 @@ func resourceLagCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// The encryption mode can only be set after the LAG has been created.
+	if v, ok := d.GetOk("encryption_mode"); ok {
+		input := &directconnect.UpdateLagInput{
+			EncryptionMode: aws.String(v.(string)),
+			LagId:          aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Direct Connect LAG: %s", input)
+		if _, err := conn.UpdateLag(input); err != nil {
+			return fmt.Errorf("error updating Direct Connect LAG (%s) encryption mode: %w", d.Id(), err)
+		}
+	}
+
 	return resourceLagRead(d, meta)
 }
 
@@ -157,9 +191,15 @@ func resourceLagRead(d *schema.ResourceData, meta interface{}) error {
 	}.String()
 	d.Set("arn", arn)
 	d.Set("connections_bandwidth", lag.ConnectionsBandwidth)
+	d.Set("encryption_mode", lag.EncryptionMode)
 	d.Set("has_logical_redundancy", lag.HasLogicalRedundancy)
 	d.Set("jumbo_frame_capable", lag.JumboFrameCapable)
 	d.Set("location", lag.Location)
+	d.Set("macsec_capable", lag.MacSecCapable)
+	// request_macsec is not returned by the API. Derive it when there is no prior value, e.g. on import.
+	if _, ok := d.GetOkExists("request_macsec"); !ok {
+		d.Set("request_macsec", lag.MacSecCapable)
+	}
 	d.Set("name", lag.LagName)
 	d.Set("owner_account_id", lag.OwnerAccount)
 	d.Set("provider_name", lag.ProviderName)
@@ -187,10 +227,17 @@ func resourceLagRead(d *schema.ResourceData, meta interface{}) error {
 func resourceLagUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
-	if d.HasChange("name") {
+	if d.HasChanges("encryption_mode", "name") {
 		input := &directconnect.UpdateLagInput{
-			LagId:   aws.String(d.Id()),
-			LagName: aws.String(d.Get("name").(string)),
+			LagId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("encryption_mode") {
+			input.EncryptionMode = aws.String(d.Get("encryption_mode").(string))
+		}
+
+		if d.HasChange("name") {
+			input.LagName = aws.String(d.Get("name").(string))
 		}
 
 		log.Printf("[DEBUG] Updating Direct Connect LAG: %s", input)
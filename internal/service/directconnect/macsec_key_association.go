@@ -0,0 +1,171 @@
+package directconnect
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMacSecKeyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMacSecKeyAssociationCreate,
+		Read:   resourceMacSecKeyAssociationRead,
+		Delete: resourceMacSecKeyAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cak": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"ckn"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a 64-character hexadecimal string"),
+			},
+			"ckn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ckn", "secret_arn"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a 64-character hexadecimal string"),
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ckn", "secret_arn"},
+				ValidateFunc: verify.ValidARN,
+			},
+			"start_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMacSecKeyAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DirectConnectConn
+
+	connectionID := d.Get("connection_id").(string)
+	input := &directconnect.AssociateMacSecKeyInput{
+		ConnectionId: aws.String(connectionID),
+	}
+
+	if v, ok := d.GetOk("ckn"); ok {
+		input.Cak = aws.String(d.Get("cak").(string))
+		input.Ckn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretARN = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect MACsec Key Association: %s", input)
+	output, err := conn.AssociateMacSecKey(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Connection (%s) MACsec Key Association: %w", connectionID, err)
+	}
+
+	// When a CKN/CAK pair is supplied, Direct Connect stores it in a new Secrets Manager secret.
+	var secretARN string
+	for _, macSecKey := range output.MacSecKeys {
+		if macSecKey == nil {
+			continue
+		}
+
+		if input.SecretARN != nil && aws.StringValue(macSecKey.SecretARN) == aws.StringValue(input.SecretARN) {
+			secretARN = aws.StringValue(macSecKey.SecretARN)
+			break
+		}
+
+		if input.Ckn != nil && aws.StringValue(macSecKey.Ckn) == aws.StringValue(input.Ckn) {
+			secretARN = aws.StringValue(macSecKey.SecretARN)
+			break
+		}
+	}
+
+	if secretARN == "" {
+		return fmt.Errorf("error creating Direct Connect Connection (%s) MACsec Key Association: secret ARN not found in response", connectionID)
+	}
+
+	d.SetId(MacSecKeyAssociationCreateResourceID(secretARN, connectionID))
+
+	return resourceMacSecKeyAssociationRead(d, meta)
+}
+
+func resourceMacSecKeyAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DirectConnectConn
+
+	secretARN, connectionID, err := MacSecKeyAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	macSecKey, err := FindMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Direct Connect MACsec Key Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Direct Connect MACsec Key Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("ckn", macSecKey.Ckn)
+	d.Set("connection_id", connectionID)
+	d.Set("secret_arn", macSecKey.SecretARN)
+	d.Set("start_on", macSecKey.StartOn)
+	d.Set("state", macSecKey.State)
+
+	return nil
+}
+
+func resourceMacSecKeyAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DirectConnectConn
+
+	secretARN, connectionID, err := MacSecKeyAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Direct Connect MACsec Key Association: %s", d.Id())
+	_, err = conn.DisassociateMacSecKey(&directconnect.DisassociateMacSecKeyInput{
+		ConnectionId: aws.String(connectionID),
+		SecretARN:    aws.String(secretARN),
+	})
+
+	if tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "Could not find") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Direct Connect MACsec Key Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
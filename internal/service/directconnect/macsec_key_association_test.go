@@ -0,0 +1,169 @@
+package directconnect_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdirectconnect "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDirectConnectMacSecKeyAssociation_withCkn(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionID := os.Getenv(key)
+	if connectionID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_macsec_key_association.test"
+	ckn := testAccMacSecGenerateHex()
+	cak := testAccMacSecGenerateHex()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, directconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMacSecKeyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacSecKeyAssociationCknConfig(connectionID, ckn, cak),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMacSecKeyAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionID),
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDirectConnectMacSecKeyAssociation_withSecret(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionID := os.Getenv(key)
+	if connectionID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dx_macsec_key_association.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, directconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMacSecKeyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacSecKeyAssociationSecretConfig(rName, connectionID, testAccMacSecGenerateHex(), testAccMacSecGenerateHex()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMacSecKeyAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionID),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_arn", secretResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMacSecKeyAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_macsec_key_association" {
+			continue
+		}
+
+		secretARN, connectionID, err := tfdirectconnect.MacSecKeyAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdirectconnect.FindMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Direct Connect MACsec Key Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMacSecKeyAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Direct Connect MACsec Key Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn
+
+		secretARN, connectionID, err := tfdirectconnect.MacSecKeyAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdirectconnect.FindMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+		return err
+	}
+}
+
+// testAccMacSecGenerateHex generates a 64-character hexadecimal string for use as a CKN or CAK.
+func testAccMacSecGenerateHex() string {
+	return sdkacctest.RandStringFromCharSet(64, "0123456789abcdef")
+}
+
+func testAccMacSecKeyAssociationCknConfig(connectionID, ckn, cak string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id = %[1]q
+  ckn           = %[2]q
+  cak           = %[3]q
+}
+`, connectionID, ckn, cak)
+}
+
+func testAccMacSecKeyAssociationSecretConfig(rName, connectionID, ckn, cak string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    ckn = %[3]q
+    cak = %[4]q
+  })
+}
+
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id = %[2]q
+  secret_arn    = aws_secretsmanager_secret.test.arn
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName, connectionID, ckn, cak)
+}
@@ -7,6 +7,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	connectionAssociationStatusAssociated = "associated"
+	connectionAssociationStatusPending    = "pending"
+)
+
 func statusConnectionState(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindConnectionByID(conn, id)
@@ -23,6 +28,26 @@ func statusConnectionState(conn *directconnect.DirectConnect, id string) resourc
 	}
 }
 
+func statusConnectionAssociation(conn *directconnect.DirectConnect, connectionID, lagID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConnectionByID(conn, connectionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(output.LagId) != lagID {
+			return output, connectionAssociationStatusPending, nil
+		}
+
+		return output, connectionAssociationStatusAssociated, nil
+	}
+}
+
 func statusGatewayState(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindGatewayByID(conn, id)
@@ -11,6 +11,7 @@ import (
 )
 
 const (
+	connectionAssociatedTimeout    = 5 * time.Minute
 	connectionConfirmedTimeout     = 10 * time.Minute
 	connectionDeletedTimeout       = 10 * time.Minute
 	connectionDisassociatedTimeout = 1 * time.Minute
@@ -18,6 +19,23 @@ const (
 	lagDeletedTimeout              = 10 * time.Minute
 )
 
+func waitConnectionAssociated(conn *directconnect.DirectConnect, connectionID, lagID string) (*directconnect.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connectionAssociationStatusPending},
+		Target:  []string{connectionAssociationStatusAssociated},
+		Refresh: statusConnectionAssociation(conn, connectionID, lagID),
+		Timeout: connectionAssociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*directconnect.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitConnectionConfirmed(conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{directconnect.ConnectionStatePending, directconnect.ConnectionStateOrdering, directconnect.ConnectionStateRequested},
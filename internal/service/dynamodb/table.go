@@ -287,6 +287,12 @@ func ResourceTable() *schema.Resource {
 					dynamodb.StreamViewTypeKeysOnly,
 				}, false),
 			},
+			"table_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      dynamodb.TableClassStandard,
+				ValidateFunc: validation.StringInSlice(dynamodb.TableClass_Values(), false),
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"ttl": {
@@ -392,6 +398,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		req.SSESpecification = expandDynamoDbEncryptAtRestOptions(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("table_class"); ok {
+		req.TableClass = aws.String(v.(string))
+	}
+
 	var output *dynamodb.CreateTableOutput
 	var requiresTagging bool
 	err := resource.Retry(createTableTimeout, func() *resource.RetryError {
@@ -511,6 +521,12 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("billing_mode", dynamodb.BillingModeProvisioned)
 	}
 
+	if table.TableClassSummary != nil {
+		d.Set("table_class", table.TableClassSummary.TableClass)
+	} else {
+		d.Set("table_class", dynamodb.TableClassStandard)
+	}
+
 	if table.ProvisionedThroughput != nil {
 		d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
@@ -680,6 +696,12 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("table_class") {
+		hasTableUpdate = true
+
+		input.TableClass = aws.String(d.Get("table_class").(string))
+	}
+
 	// Phase 2 of Global Secondary Index Operations: Update Only
 	// Cannot create or delete index while updating table ProvisionedThroughput
 	// Must skip all index updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
@@ -143,6 +143,10 @@ func DataSourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"table_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": tftags.TagsSchemaComputed(),
 			"ttl": {
 				Type:     schema.TypeSet,
@@ -249,6 +253,12 @@ func dataSourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("billing_mode", dynamodb.BillingModeProvisioned)
 	}
 
+	if table.TableClassSummary != nil {
+		d.Set("table_class", table.TableClassSummary.TableClass)
+	} else {
+		d.Set("table_class", dynamodb.TableClassStandard)
+	}
+
 	if table.ProvisionedThroughput != nil {
 		d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
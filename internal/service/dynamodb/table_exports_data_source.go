@@ -0,0 +1,90 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTableExports() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTableExportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"export_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceTableExportsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	input := &dynamodb.ListExportsInput{}
+
+	if v, ok := d.GetOk("table_arn"); ok {
+		input.TableArn = aws.String(v.(string))
+	}
+
+	var exports []interface{}
+
+	err := conn.ListExportsPagesWithContext(ctx, input, func(page *dynamodb.ListExportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, export := range page.ExportSummaries {
+			if export == nil {
+				continue
+			}
+
+			exports = append(exports, map[string]interface{}{
+				"arn":           aws.StringValue(export.ExportArn),
+				"export_status": aws.StringValue(export.ExportStatus),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DynamoDB Table Exports: %w", err))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if v, ok := d.GetOk("table_arn"); ok {
+		d.SetId(v.(string))
+	}
+
+	if err := d.Set("exports", exports); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting exports: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package dynamodb_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDynamoDBTableExportsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dynamodb_table_exports.test"
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exports.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "exports.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "exports.0.export_status", dynamodb.ExportStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableExportsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBasicConfig(rName), `
+data "aws_dynamodb_table_exports" "test" {
+  table_arn = aws_dynamodb_table_export.test.table_arn
+}
+`)
+}
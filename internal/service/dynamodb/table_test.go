@@ -700,6 +700,47 @@ func TestAccDynamoDBTable_streamSpecificationValidation(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_tableClass(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "table_class", dynamodb.TableClassStandard),
+				),
+			},
+			{
+				Config: testAccTableClassConfig(rName, dynamodb.TableClassStandardInfrequentAccess),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "table_class", dynamodb.TableClassStandardInfrequentAccess),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableClassConfig(rName, dynamodb.TableClassStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "table_class", dynamodb.TableClassStandard),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_tags(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 	resourceName := "aws_dynamodb_table.test"
@@ -1801,6 +1842,23 @@ resource "aws_dynamodb_table" "test" {
 `, rName, enabled, viewType)
 }
 
+func testAccTableClassConfig(rName, tableClass string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = %[1]q
+  table_class    = %[2]q
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+}
+`, rName, tableClass)
+}
+
 func testAccTagsConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -65,6 +65,12 @@ func ResourceAMI() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidUTCTimestamp,
+				DiffSuppressFunc: suppressEquivalentAMIDeprecationTime,
+			},
 			// The following block device attributes intentionally mimick the
 			// corresponding attributes on aws_instance, since they have the
 			// same meaning.
@@ -327,6 +333,12 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := enableAMIDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
 
@@ -407,6 +419,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("architecture", image.Architecture)
+	d.Set("deprecation_time", image.DeprecationTime)
 	d.Set("description", image.Description)
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
@@ -480,6 +493,18 @@ func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("deprecation_time") {
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := enableAMIDeprecation(client, d.Id(), v); err != nil {
+				return err
+			}
+		} else {
+			if err := disableAMIDeprecation(client, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
 
@@ -593,6 +618,56 @@ func resourceAMIWaitForAvailable(timeout time.Duration, id string, client *ec2.E
 	return info.(*ec2.Image), nil
 }
 
+func enableAMIDeprecation(conn *ec2.EC2, id string, deprecateAt string) error {
+	v, _ := time.Parse(time.RFC3339, deprecateAt)
+
+	input := &ec2.EnableImageDeprecationInput{
+		DeprecateAt: aws.Time(v),
+		ImageId:     aws.String(id),
+	}
+
+	_, err := conn.EnableImageDeprecation(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling EC2 AMI (%s) deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
+func disableAMIDeprecation(conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeprecation(input)
+
+	if err != nil {
+		return fmt.Errorf("error disabling EC2 AMI (%s) deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
+// suppressEquivalentAMIDeprecationTime suppresses differences caused by EC2
+// returning the deprecation time with millisecond precision and rounded to the
+// nearest minute.
+func suppressEquivalentAMIDeprecationTime(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := time.Parse(time.RFC3339, new)
+
+	if err != nil {
+		return false
+	}
+
+	return o.Equal(n.Round(time.Minute))
+}
+
 func expandEc2BlockDeviceMappingForAmiEbsBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
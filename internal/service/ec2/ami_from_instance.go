@@ -36,6 +36,12 @@ func ResourceAMIFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidUTCTimestamp,
+				DiffSuppressFunc: suppressEquivalentAMIDeprecationTime,
+			},
 			// The following block device attributes intentionally mimick the
 			// corresponding attributes on aws_instance, since they have the
 			// same meaning.
@@ -250,5 +256,11 @@ func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := enableAMIDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
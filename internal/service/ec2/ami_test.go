@@ -166,6 +166,52 @@ func TestAccEC2AMI_description(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMI_deprecationTime(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	deprecateAt := time.Now().UTC().AddDate(0, 0, 1).Truncate(time.Minute).Format(time.RFC3339)
+	deprecateAtUpdated := time.Now().UTC().AddDate(0, 0, 2).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigDeprecationTime(rName, deprecateAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttrSet(resourceName, "deprecation_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				Config: testAccAmiConfigDeprecationTime(rName, deprecateAtUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttrSet(resourceName, "deprecation_time"),
+				),
+			},
+			{
+				Config: testAccAmiConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMI_disappears(t *testing.T) {
 	var ami ec2.Image
 	resourceName := "aws_ami.test"
@@ -497,6 +543,25 @@ resource "aws_ami" "test" {
 `, rName, desc))
 }
 
+func testAccAmiConfigDeprecationTime(rName, deprecateAt string) string {
+	return acctest.ConfigCompose(
+		testAccAmiConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+  deprecation_time    = %[2]q
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+`, rName, deprecateAt))
+}
+
 func testAccAmiConfigEphemeralBlockDevices(rName string) string {
 	return acctest.ConfigCompose(
 		testAccAmiConfigBase(rName),
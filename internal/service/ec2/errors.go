@@ -33,6 +33,10 @@ const (
 	ErrCodeInvalidInternetGatewayIDNotFound = "InvalidInternetGatewayID.NotFound"
 )
 
+const (
+	ErrCodeInvalidNetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+)
+
 const (
 	ErrCodeInvalidNetworkInterfaceIDNotFound = "InvalidNetworkInterfaceID.NotFound"
 )
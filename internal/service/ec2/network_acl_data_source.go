@@ -0,0 +1,206 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceNetworkACL() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkACLRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"egress":  networkACLEntriesSchemaComputed(),
+			"filter":  CustomFiltersSchema(),
+			"ingress": networkACLEntriesSchemaComputed(),
+			"network_acl_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func networkACLEntriesSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cidr_block": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"from_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"icmp_code": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"icmp_type": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"ipv6_cidr_block": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_no": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"to_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+		Set: resourceNetworkACLEntryHash,
+	}
+}
+
+func dataSourceNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeNetworkAclsInput{}
+
+	if v, ok := d.GetOk("network_acl_id"); ok {
+		input.NetworkAclIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	// We specify "default" as boolean, but EC2 filters want
+	// it to be serialized as a string. Note that setting it to
+	// "false" here does not actually filter by it *not* being
+	// the default, because Terraform can't distinguish between
+	// "false" and "not set".
+	isDefaultStr := ""
+	if d.Get("default").(bool) {
+		isDefaultStr = "true"
+	}
+
+	filters := map[string]string{
+		"default": isDefaultStr,
+		"vpc-id":  d.Get("vpc_id").(string),
+	}
+
+	input.Filters = BuildAttributeFilterList(filters)
+
+	input.Filters = append(input.Filters, BuildTagFilterList(
+		Tags(tftags.New(d.Get("tags").(map[string]interface{}))),
+	)...)
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	networkACL, err := FindNetworkACL(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("EC2 Network ACL", err)
+	}
+
+	d.SetId(aws.StringValue(networkACL.NetworkAclId))
+
+	ownerID := aws.StringValue(networkACL.OwnerId)
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: ownerID,
+		Resource:  fmt.Sprintf("network-acl/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("default", networkACL.IsDefault)
+	d.Set("network_acl_id", networkACL.NetworkAclId)
+	d.Set("owner_id", ownerID)
+	d.Set("vpc_id", networkACL.VpcId)
+
+	var ingressEntries []*ec2.NetworkAclEntry
+	var egressEntries []*ec2.NetworkAclEntry
+
+	for _, e := range networkACL.Entries {
+		// Skip the default rules added by AWS, matching the aws_network_acl resource.
+		if aws.Int64Value(e.RuleNumber) == defaultACLRuleNumberIPv4 ||
+			aws.Int64Value(e.RuleNumber) == defaultACLRuleNumberIPv6 {
+			continue
+		}
+
+		if aws.BoolValue(e.Egress) {
+			egressEntries = append(egressEntries, e)
+		} else {
+			ingressEntries = append(ingressEntries, e)
+		}
+	}
+
+	if err := d.Set("ingress", networkAclEntriesToMapList(ingressEntries)); err != nil {
+		return fmt.Errorf("error setting ingress: %w", err)
+	}
+
+	if err := d.Set("egress", networkAclEntriesToMapList(egressEntries)); err != nil {
+		return fmt.Errorf("error setting egress: %w", err)
+	}
+
+	var subnetIDs []*string
+	for _, a := range networkACL.Associations {
+		subnetIDs = append(subnetIDs, a.SubnetId)
+	}
+
+	if err := d.Set("subnet_ids", flex.FlattenStringSet(subnetIDs)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %w", err)
+	}
+
+	if err := d.Set("tags", KeyValueTags(networkACL.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2NetworkACLDataSource_basic(t *testing.T) {
+	resourceName := "aws_network_acl.test"
+	ds1ResourceName := "data.aws_network_acl.by_id"
+	ds2ResourceName := "data.aws_network_acl.by_filter"
+	ds3ResourceName := "data.aws_network_acl.by_tags"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkACLDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(ds1ResourceName, "default", "false"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "egress.#", resourceName, "egress.#"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "ingress.#", resourceName, "ingress.#"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "network_acl_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "vpc_id", resourceName, "vpc_id"),
+
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "network_acl_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "ingress.#", resourceName, "ingress.#"),
+
+					resource.TestCheckResourceAttrPair(ds3ResourceName, "network_acl_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds3ResourceName, "egress.#", resourceName, "egress.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkACLDataSource_default(t *testing.T) {
+	dataSourceName := "data.aws_network_acl.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkACLDataSourceDefaultConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_acl_id", vpcResourceName, "default_network_acl_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "default", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkACLDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.1.1.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id     = aws_vpc.test.id
+  subnet_ids = [aws_subnet.test.id]
+
+  ingress {
+    protocol   = "tcp"
+    rule_no    = 100
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  egress {
+    protocol   = "tcp"
+    rule_no    = 200
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_network_acl" "by_id" {
+  network_acl_id = aws_network_acl.test.id
+}
+
+data "aws_network_acl" "by_filter" {
+  filter {
+    name   = "network-acl-id"
+    values = [aws_network_acl.test.id]
+  }
+}
+
+data "aws_network_acl" "by_tags" {
+  vpc_id = aws_network_acl.test.vpc_id
+
+  tags = {
+    Name = aws_network_acl.test.tags["Name"]
+  }
+}
+`, rName)
+}
+
+func testAccNetworkACLDataSourceDefaultConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_network_acl" "test" {
+  vpc_id  = aws_vpc.test.id
+  default = true
+}
+`, rName)
+}
@@ -0,0 +1,199 @@
+package ecr
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceRegistryScanningConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRegistryScanningConfigurationPut,
+		Read:   resourceRegistryScanningConfigurationRead,
+		Update: resourceRegistryScanningConfigurationPut,
+		Delete: resourceRegistryScanningConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_filter": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecr.ScanningRepositoryFilterType_Values(), false),
+									},
+								},
+							},
+						},
+						"scan_frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ecr.ScanFrequency_Values(), false),
+						},
+					},
+				},
+			},
+			"scan_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ecr.ScanType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRegistryScanningConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	input := &ecr.PutRegistryScanningConfigurationInput{
+		Rules:    expandEcrRegistryScanningRules(d.Get("rule").(*schema.Set).List()),
+		ScanType: aws.String(d.Get("scan_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting ECR Registry Scanning Configuration: %s", input)
+	_, err := conn.PutRegistryScanningConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ECR Registry Scanning Configuration: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceRegistryScanningConfigurationRead(d, meta)
+}
+
+func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	log.Printf("[DEBUG] Reading ECR Registry Scanning Configuration %s", d.Id())
+	out, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+	}
+
+	if out == nil || out.ScanningConfiguration == nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): empty response", d.Id())
+	}
+
+	d.Set("registry_id", out.RegistryId)
+	d.Set("scan_type", out.ScanningConfiguration.ScanType)
+
+	if err := d.Set("rule", flattenEcrRegistryScanningRules(out.ScanningConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	// Restore the registry default of basic scanning with no rules.
+	input := &ecr.PutRegistryScanningConfigurationInput{
+		Rules:    []*ecr.RegistryScanningRule{},
+		ScanType: aws.String(ecr.ScanTypeBasic),
+	}
+
+	log.Printf("[DEBUG] Deleting ECR Registry Scanning Configuration: %s", d.Id())
+	_, err := conn.PutRegistryScanningConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcrRegistryScanningRules(data []interface{}) []*ecr.RegistryScanningRule {
+	var rules []*ecr.RegistryScanningRule
+
+	for _, rule := range data {
+		ec, ok := rule.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		config := &ecr.RegistryScanningRule{
+			ScanFrequency: aws.String(ec["scan_frequency"].(string)),
+		}
+
+		if v, ok := ec["repository_filter"].(*schema.Set); ok && v.Len() > 0 {
+			for _, filter := range v.List() {
+				ec := filter.(map[string]interface{})
+
+				config.RepositoryFilters = append(config.RepositoryFilters, &ecr.ScanningRepositoryFilter{
+					Filter:     aws.String(ec["filter"].(string)),
+					FilterType: aws.String(ec["filter_type"].(string)),
+				})
+			}
+		}
+
+		rules = append(rules, config)
+	}
+
+	return rules
+}
+
+func flattenEcrRegistryScanningRules(ec []*ecr.RegistryScanningRule) []interface{} {
+	if len(ec) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range ec {
+		if apiObject == nil {
+			continue
+		}
+
+		var filters []interface{}
+
+		for _, filter := range apiObject.RepositoryFilters {
+			if filter == nil {
+				continue
+			}
+
+			filters = append(filters, map[string]interface{}{
+				"filter":      aws.StringValue(filter.Filter),
+				"filter_type": aws.StringValue(filter.FilterType),
+			})
+		}
+
+		tfMap := map[string]interface{}{
+			"repository_filter": filters,
+			"scan_frequency":    aws.StringValue(apiObject.ScanFrequency),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
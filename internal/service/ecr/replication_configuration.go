@@ -3,10 +3,12 @@ package ecr
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -35,12 +37,13 @@ func ResourceReplicationConfiguration() *schema.Resource {
 						"rule": {
 							Type:     schema.TypeList,
 							Required: true,
-							MaxItems: 1,
+							MaxItems: 10,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"destination": {
 										Type:     schema.TypeList,
 										Required: true,
+										MaxItems: 25,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"region": {
@@ -55,6 +58,29 @@ func ResourceReplicationConfiguration() *schema.Resource {
 											},
 										},
 									},
+									"repository_filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"filter": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(2, 256),
+														validation.StringMatch(regexp.MustCompile(`^(?:[a-z0-9]+(?:[._-][a-z0-9]*)*/)*[a-z0-9]*(?:[._-][a-z0-9]*)*$`), "must be a valid repository name prefix"),
+													),
+												},
+												"filter_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(ecr.RepositoryFilterType_Values(), false),
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -156,6 +182,10 @@ func expandEcrReplicationConfigurationReplicationConfigurationRules(data []inter
 			Destinations: expandEcrReplicationConfigurationReplicationConfigurationRulesDestinations(ec["destination"].([]interface{})),
 		}
 
+		if v, ok := ec["repository_filter"].([]interface{}); ok && len(v) > 0 {
+			config.RepositoryFilters = expandEcrReplicationConfigurationReplicationConfigurationRulesRepositoryFilters(v)
+		}
+
 		rules = append(rules, config)
 
 	}
@@ -171,7 +201,8 @@ func flattenEcrReplicationConfigurationReplicationConfigurationRules(ec []*ecr.R
 
 	for _, apiObject := range ec {
 		tfMap := map[string]interface{}{
-			"destination": flattenEcrReplicationConfigurationReplicationConfigurationRulesDestinations(apiObject.Destinations),
+			"destination":       flattenEcrReplicationConfigurationReplicationConfigurationRulesDestinations(apiObject.Destinations),
+			"repository_filter": flattenEcrReplicationConfigurationReplicationConfigurationRulesRepositoryFilters(apiObject.RepositoryFilters),
 		}
 
 		tfList = append(tfList, tfMap)
@@ -217,3 +248,41 @@ func flattenEcrReplicationConfigurationReplicationConfigurationRulesDestinations
 
 	return tfList
 }
+
+func expandEcrReplicationConfigurationReplicationConfigurationRulesRepositoryFilters(data []interface{}) []*ecr.RepositoryFilter {
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	var filters []*ecr.RepositoryFilter
+
+	for _, filter := range data {
+		ec := filter.(map[string]interface{})
+		config := &ecr.RepositoryFilter{
+			Filter:     aws.String(ec["filter"].(string)),
+			FilterType: aws.String(ec["filter_type"].(string)),
+		}
+
+		filters = append(filters, config)
+	}
+	return filters
+}
+
+func flattenEcrReplicationConfigurationReplicationConfigurationRulesRepositoryFilters(ec []*ecr.RepositoryFilter) []interface{} {
+	if len(ec) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range ec {
+		tfMap := map[string]interface{}{
+			"filter":      aws.StringValue(apiObject.Filter),
+			"filter_type": aws.StringValue(apiObject.FilterType),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
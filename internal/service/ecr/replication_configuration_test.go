@@ -63,6 +63,18 @@ func TestAccECRReplicationConfiguration_basic(t *testing.T) {
 					acctest.CheckResourceAttrAccountID(resourceName, "replication_configuration.0.rule.0.destination.0.registry_id"),
 				),
 			},
+			{
+				Config: testAccReplicationRepositoryFilterConfiguration(acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter", "a-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter_type", "PREFIX_MATCH"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.1.repository_filter.#", "2"),
+				),
+			},
 		},
 	})
 }
@@ -148,3 +160,42 @@ resource "aws_ecr_replication_configuration" "test" {
 }
 `, region1, region2)
 }
+
+func testAccReplicationRepositoryFilterConfiguration(region string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      repository_filter {
+        filter      = "a-prefix"
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      repository_filter {
+        filter      = "another-prefix"
+        filter_type = "PREFIX_MATCH"
+      }
+
+      repository_filter {
+        filter      = "third/prefix"
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+  }
+}
+`, region)
+}
@@ -0,0 +1,144 @@
+package ecrpublic_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecrpublic "github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
+)
+
+func TestAccECRPublicRepositoryPolicy_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecrpublic.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryPolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_name", "aws_ecrpublic_repository.test", "repository_name"),
+					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRepositoryPolicyConfig(rName, "ecr-public:DescribeRepositories"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryPolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile("ecr-public:DescribeRepositories")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRPublicRepositoryPolicy_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecrpublic.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecrpublic.ResourceRepositoryPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecrpublic_repository_policy" {
+			continue
+		}
+
+		_, err := conn.GetRepositoryPolicy(&ecrpublic.GetRepositoryPolicyInput{
+			RegistryId:     aws.String(rs.Primary.Attributes["registry_id"]),
+			RepositoryName: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException, ecrpublic.ErrCodeRepositoryPolicyNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECR Public Repository Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRepositoryPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Public Repository Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+
+		_, err := conn.GetRepositoryPolicy(&ecrpublic.GetRepositoryPolicyInput{
+			RepositoryName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccRepositoryPolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+}
+
+resource "aws_ecrpublic_repository_policy" "test" {
+  repository_name = aws_ecrpublic_repository.test.repository_name
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Sid       = %[1]q
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = [%[2]q]
+    }]
+  })
+}
+`, rName, action)
+}
@@ -1,8 +1,12 @@
 package ecs
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -65,3 +69,77 @@ func FindClusterByARN(conn *ecs.ECS, arn string) (*ecs.DescribeClustersOutput, e
 
 	return output, nil
 }
+
+func FindTasksByClusterAndARNs(conn *ecs.ECS, cluster string, arns []string) ([]*ecs.Task, error) {
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   aws.StringSlice(arns),
+	}
+
+	output, err := conn.DescribeTasks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(output.Failures) > 0 {
+		var failures []string
+
+		for _, apiObject := range output.Failures {
+			if apiObject == nil {
+				continue
+			}
+
+			failures = append(failures, fmt.Sprintf("%s: %s", aws.StringValue(apiObject.Arn), aws.StringValue(apiObject.Reason)))
+		}
+
+		return nil, fmt.Errorf("error describing ECS Tasks: %s", strings.Join(failures, ", "))
+	}
+
+	if len(output.Tasks) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Tasks, nil
+}
+
+func FindTaskSetByID(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Include:  aws.StringSlice([]string{ecs.TaskSetFieldTags}),
+		Service:  aws.String(service),
+		TaskSets: aws.StringSlice([]string{taskSetID}),
+	}
+
+	output, err := conn.DescribeTaskSets(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskSets) == 0 || output.TaskSets[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.TaskSets[0], nil
+}
@@ -37,6 +37,8 @@ func ResourceService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -534,27 +536,8 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.PlacementConstraints = pc
 	}
 
-	serviceRegistries := d.Get("service_registries").([]interface{})
-	if len(serviceRegistries) > 0 {
-		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
-		for _, v := range serviceRegistries {
-			raw := v.(map[string]interface{})
-			sr := &ecs.ServiceRegistry{
-				RegistryArn: aws.String(raw["registry_arn"].(string)),
-			}
-			if port, ok := raw["port"].(int); ok && port != 0 {
-				sr.Port = aws.Int64(int64(port))
-			}
-			if raw, ok := raw["container_port"].(int); ok && raw != 0 {
-				sr.ContainerPort = aws.Int64(int64(raw))
-			}
-			if raw, ok := raw["container_name"].(string); ok && raw != "" {
-				sr.ContainerName = aws.String(raw)
-			}
-
-			srs = append(srs, sr)
-		}
-		input.ServiceRegistries = srs
+	if v, ok := d.GetOk("service_registries"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceRegistries = expandServiceRegistries(v.([]interface{}))
 	}
 
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
@@ -614,7 +597,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
 	}
@@ -1188,7 +1171,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
 	}
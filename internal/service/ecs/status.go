@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,8 +20,24 @@ const (
 	serviceStatusError = "ERROR"
 	serviceStatusNone  = "NONE"
 
+	serviceStatusPending = "tfPENDING"
+	serviceStatusStable  = "tfSTABLE"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
+
+	taskSetStatusActive   = "ACTIVE"
+	taskSetStatusDraining = "DRAINING"
+	taskSetStatusPrimary  = "PRIMARY"
+
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusStopping       = "STOPPING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusStopped        = "STOPPED"
 )
 
 func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -81,6 +98,65 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+// statusServiceWaitForStable reports whether the service has a single deployment
+// running the desired number of tasks, mirroring the SDK's ServicesStable waiter.
+// A failed PRIMARY deployment (e.g. one rolled back by the circuit breaker) is
+// reported as an error carrying the rollout state reason. A missing or INACTIVE
+// service will never become stable and is reported as not found.
+func statusServiceWaitForStable(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeServicesInput{
+			Services: aws.StringSlice([]string{id}),
+		}
+
+		if cluster != "" {
+			input.Cluster = aws.String(cluster)
+		}
+
+		output, err := conn.DescribeServices(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output != nil {
+			for _, failure := range output.Failures {
+				if aws.StringValue(failure.Reason) == "MISSING" {
+					return nil, "", &resource.NotFoundError{
+						LastRequest: input,
+						Message:     fmt.Sprintf("ECS Service (%s) missing", id),
+					}
+				}
+			}
+		}
+
+		if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+			return nil, "", tfresource.NewEmptyResultError(input)
+		}
+
+		service := output.Services[0]
+
+		if status := aws.StringValue(service.Status); status == serviceStatusInactive {
+			return nil, "", &resource.NotFoundError{
+				LastRequest: input,
+				Message:     fmt.Sprintf("ECS Service (%s) is %s", id, status),
+			}
+		}
+
+		for _, deployment := range service.Deployments {
+			if aws.StringValue(deployment.Status) == "PRIMARY" && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+				return service, ecs.DeploymentRolloutStateFailed, fmt.Errorf("deployment (%s) failed: %s", aws.StringValue(deployment.Id), aws.StringValue(deployment.RolloutStateReason))
+			}
+		}
+
+		if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+			return service, serviceStatusStable, nil
+		}
+
+		return service, serviceStatusPending, nil
+	}
+}
+
 func statusCluster(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindClusterByARN(conn, arn)
@@ -100,3 +176,59 @@ func statusCluster(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.Clusters[0].Status), err
 	}
 }
+
+// statusTasks returns the status of the first task that has not yet stopped,
+// or STOPPED once all of the tasks have stopped.
+func statusTasks(conn *ecs.ECS, cluster string, arns []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTasksByClusterAndARNs(conn, cluster, arns)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, task := range output {
+			if status := aws.StringValue(task.LastStatus); status != taskStatusStopped {
+				return output, status, nil
+			}
+		}
+
+		return output, taskStatusStopped, nil
+	}
+}
+
+func statusTaskSetStability(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetByID(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.StabilityStatus), nil
+	}
+}
+
+func statusTaskSet(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetByID(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,520 @@
+package ecs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTaskExecutionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_provider_strategy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"launch_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"desired_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"enable_ecs_managed_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"enable_execute_command": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"capacity_provider_strategy"},
+				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_overrides": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"cpu": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"environment": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"memory": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"memory_reservation": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"cpu": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"execution_role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"task_role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"placement_constraints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ecs.PlacementConstraintType_Values(), false),
+						},
+					},
+				},
+			},
+			"placement_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ecs.PlacementStrategyType_Values(), false),
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"propagate_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+			},
+			"reference_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"started_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tftags.TagsSchema(),
+			"task_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"containers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exit_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"stop_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stopped_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskExecutionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	cluster := d.Get("cluster").(string)
+	taskDefinition := d.Get("task_definition").(string)
+
+	input := &ecs.RunTaskInput{
+		Cluster:        aws.String(cluster),
+		Count:          aws.Int64(int64(d.Get("desired_count").(int))),
+		TaskDefinition: aws.String(taskDefinition),
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
+		input.CapacityProviderStrategy = expandEcsCapacityProviderStrategy(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("enable_ecs_managed_tags"); ok {
+		input.EnableECSManagedTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("enable_execute_command"); ok {
+		input.EnableExecuteCommand = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("group"); ok {
+		input.Group = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok {
+		input.NetworkConfiguration = expandEcsNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Overrides = expandTaskOverride(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("placement_constraints"); ok && v.(*schema.Set).Len() > 0 {
+		apiObjects, err := expandPlacementConstraints(v.(*schema.Set).List())
+
+		if err != nil {
+			return err
+		}
+
+		input.PlacementConstraints = apiObjects
+	}
+
+	if v, ok := d.GetOk("placement_strategy"); ok && len(v.([]interface{})) > 0 {
+		apiObjects, err := expandPlacementStrategy(v.([]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		input.PlacementStrategy = apiObjects
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("propagate_tags"); ok {
+		input.PropagateTags = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reference_id"); ok {
+		input.ReferenceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("started_by"); ok {
+		input.StartedBy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Tags = Tags(tftags.New(v.(map[string]interface{})).IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Running ECS Task: %s", input)
+	output, err := conn.RunTask(input)
+
+	if err != nil {
+		return fmt.Errorf("error running ECS Task (%s) in Cluster (%s): %w", taskDefinition, cluster, err)
+	}
+
+	if len(output.Failures) > 0 {
+		var failures []string
+
+		for _, apiObject := range output.Failures {
+			if apiObject == nil {
+				continue
+			}
+
+			failures = append(failures, fmt.Sprintf("%s: %s", aws.StringValue(apiObject.Arn), aws.StringValue(apiObject.Reason)))
+		}
+
+		return fmt.Errorf("error running ECS Task (%s) in Cluster (%s): %s", taskDefinition, cluster, strings.Join(failures, ", "))
+	}
+
+	var taskARNs []string
+
+	for _, apiObject := range output.Tasks {
+		if apiObject == nil {
+			continue
+		}
+
+		taskARNs = append(taskARNs, aws.StringValue(apiObject.TaskArn))
+	}
+
+	if len(taskARNs) == 0 {
+		return fmt.Errorf("error running ECS Task (%s) in Cluster (%s): empty result", taskDefinition, cluster)
+	}
+
+	tasks, err := waitTasksStopped(conn, cluster, taskARNs, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for ECS Tasks (%s) to stop: %w", strings.Join(taskARNs, ", "), err)
+	}
+
+	d.SetId(strings.Join(taskARNs, ","))
+	d.Set("task_arns", taskARNs)
+
+	if err := d.Set("tasks", flattenTaskExecutionTasks(tasks)); err != nil {
+		return fmt.Errorf("error setting tasks: %w", err)
+	}
+
+	return nil
+}
+
+func expandTaskOverride(tfMap map[string]interface{}) *ecs.TaskOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.TaskOverride{}
+
+	if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ContainerOverrides = expandContainerOverrides(v)
+	}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerOverrides(tfList []interface{}) []*ecs.ContainerOverride {
+	var apiObjects []*ecs.ContainerOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerOverride{}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(tfMap["key"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTaskExecutionTasks(apiObjects []*ecs.Task) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var containers []interface{}
+
+		for _, container := range apiObject.Containers {
+			if container == nil {
+				continue
+			}
+
+			containers = append(containers, map[string]interface{}{
+				"exit_code": int(aws.Int64Value(container.ExitCode)),
+				"name":      aws.StringValue(container.Name),
+				"reason":    aws.StringValue(container.Reason),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":            aws.StringValue(apiObject.TaskArn),
+			"containers":     containers,
+			"stop_code":      aws.StringValue(apiObject.StopCode),
+			"stopped_reason": aws.StringValue(apiObject.StoppedReason),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,186 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSTaskExecutionDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_task_execution.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "task_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.exit_code", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.stop_code", ecs.TaskStopCodeEssentialContainerExited),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskExecutionDataSource_overrides(t *testing.T) {
+	dataSourceName := "data.aws_ecs_task_execution.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionDataSourceConfig_overrides(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "task_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.exit_code", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskExecutionDataSourceConfigBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone       = data.aws_availability_zones.available.names[0]
+  cidr_block              = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+  map_public_ip_on_launch = true
+  vpc_id                  = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([
+    {
+      name      = %[1]q
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      essential = true
+      command   = ["sh", "-c", "exit 0"]
+    }
+  ])
+}
+`, rName))
+}
+
+func testAccTaskExecutionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTaskExecutionDataSourceConfigBase(rName), `
+data "aws_ecs_task_execution" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    assign_public_ip = true
+    security_groups  = [aws_security_group.test.id]
+    subnets          = [aws_subnet.test.id]
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`)
+}
+
+func testAccTaskExecutionDataSourceConfig_overrides(rName string, exitCode int) string {
+	return acctest.ConfigCompose(testAccTaskExecutionDataSourceConfigBase(rName), fmt.Sprintf(`
+data "aws_ecs_task_execution" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    assign_public_ip = true
+    security_groups  = [aws_security_group.test.id]
+    subnets          = [aws_subnet.test.id]
+  }
+
+  overrides {
+    container_overrides {
+      name    = %[1]q
+      command = ["sh", "-c", "exit $EXIT_CODE"]
+
+      environment {
+        key   = "EXIT_CODE"
+        value = "%[2]d"
+      }
+    }
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName, exitCode))
+}
@@ -1,11 +1,13 @@
 package ecs
 
 import (
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -17,10 +19,15 @@ const (
 	serviceInactiveTimeoutMin = 1 * time.Second
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
+	serviceStableDelay        = 15 * time.Second
 
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
 	clusterAvailableDelay   = 10 * time.Second
+
+	tasksStoppedDelay = 10 * time.Second
+
+	taskSetDeleteTimeout = 10 * time.Minute
 )
 
 func waitCapacityProviderDeleted(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -51,25 +58,32 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	outputRaw, err := stateConf.WaitForState()
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
+		if aws.StringValue(v.UpdateStatus) == ecs.CapacityProviderUpdateStatusUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.UpdateStatusReason)))
+		}
+
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{serviceStatusPending},
+		Target:  []string{serviceStatusStable},
+		Refresh: statusServiceWaitForStable(conn, id, cluster),
+		Timeout: timeout,
+		Delay:   serviceStableDelay,
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
-	}
+	outputRaw, err := stateConf.WaitForState()
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
-		return err
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		return v, err
 	}
-	return nil
+
+	return nil, err
 }
 
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
@@ -153,3 +167,63 @@ func waitClusterDeleted(conn *ecs.ECS, arn string) (*ecs.Cluster, error) {
 
 	return nil, err
 }
+
+func waitTasksStopped(conn *ecs.ECS, cluster string, arns []string, timeout time.Duration) ([]*ecs.Task, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			taskStatusProvisioning,
+			taskStatusPending,
+			taskStatusActivating,
+			taskStatusRunning,
+			taskStatusDeactivating,
+			taskStatusStopping,
+			taskStatusDeprovisioning,
+		},
+		Target:  []string{taskStatusStopped},
+		Refresh: statusTasks(conn, cluster, arns),
+		Timeout: timeout,
+		Delay:   tasksStoppedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.([]*ecs.Task); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTaskSetStable(conn *ecs.ECS, timeout time.Duration, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.StabilityStatusStabilizing},
+		Target:  []string{ecs.StabilityStatusSteadyState},
+		Refresh: statusTaskSetStability(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{taskSetStatusActive, taskSetStatusPrimary, taskSetStatusDraining},
+		Target:  []string{},
+		Refresh: statusTaskSet(conn, taskSetID, service, cluster),
+		Timeout: taskSetDeleteTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -38,6 +38,13 @@ func ResourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ForceNewIfChange("encryption_config.0.provider.0.key_arn", func(_ context.Context, old, new, meta interface{}) bool {
+				// The KMS key of an existing encryption config cannot be changed.
+				return old.(string) != ""
+			}),
+			customdiff.ForceNewIfChange("encryption_config.0.resources", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(*schema.Set).Len() > 0
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
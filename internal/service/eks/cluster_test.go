@@ -120,7 +120,7 @@ func TestAccEKSCluster_Encryption_create(t *testing.T) {
 }
 
 func TestAccEKSCluster_Encryption_update(t *testing.T) {
-	var cluster1, cluster2 eks.Cluster
+	var cluster1, cluster2, cluster3 eks.Cluster
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 	kmsKeyResourceName := "aws_kms_key.test"
@@ -154,6 +154,15 @@ func TestAccEKSCluster_Encryption_update(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// Envelope encryption cannot be disabled, so removing it recreates the cluster.
+				Config: testAccClusterConfig_Required(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster3),
+					testAccCheckClusterRecreated(&cluster2, &cluster3),
+					resource.TestCheckResourceAttr(resourceName, "encryption_config.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -155,7 +155,10 @@ func resourceIdentityProviderConfigCreate(ctx context.Context, d *schema.Resourc
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
-	_, err := conn.AssociateIdentityProviderConfig(input)
+	// Only one update can be in progress on a cluster at a time.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.AssociateIdentityProviderConfigWithContext(ctx, input)
+	}, eks.ErrCodeResourceInUseException)
 
 	if err != nil {
 		return diag.Errorf("error associating EKS Identity Provider Config (%s): %s", id, err)
@@ -241,13 +244,15 @@ func resourceIdentityProviderConfigDelete(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Disassociating EKS Identity Provider Config: %s", d.Id())
-	_, err = conn.DisassociateIdentityProviderConfigWithContext(ctx, &eks.DisassociateIdentityProviderConfigInput{
-		ClusterName: aws.String(clusterName),
-		IdentityProviderConfig: &eks.IdentityProviderConfig{
-			Name: aws.String(configName),
-			Type: aws.String(IdentityProviderConfigTypeOIDC),
-		},
-	})
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DisassociateIdentityProviderConfigWithContext(ctx, &eks.DisassociateIdentityProviderConfigInput{
+			ClusterName: aws.String(clusterName),
+			IdentityProviderConfig: &eks.IdentityProviderConfig{
+				Name: aws.String(configName),
+				Type: aws.String(IdentityProviderConfigTypeOIDC),
+			},
+		})
+	}, eks.ErrCodeResourceInUseException)
 
 	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
 		return nil
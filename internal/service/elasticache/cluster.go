@@ -434,21 +434,23 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", c.ARN)
 
-	tags, err := ListTags(conn, aws.StringValue(c.ARN))
+	if !skipTagsRefresh(d, ignoreTagsConfig) {
+		tags, err := ListTags(conn, aws.StringValue(c.ARN))
 
-	if err != nil {
-		return fmt.Errorf("error listing tags for ElastiCache Cluster (%s): %w", d.Id(), err)
-	}
+		if err != nil {
+			return fmt.Errorf("error listing tags for ElastiCache Cluster (%s): %w", d.Id(), err)
+		}
 
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
-	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
-	}
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+			return fmt.Errorf("error setting tags: %w", err)
+		}
 
-	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all: %w", err)
+		}
 	}
 
 	return nil
@@ -126,21 +126,23 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("description", describeResp.CacheParameterGroups[0].Description)
 	d.Set("arn", describeResp.CacheParameterGroups[0].ARN)
 
-	tags, err := ListTags(conn, aws.StringValue(describeResp.CacheParameterGroups[0].ARN))
+	if !skipTagsRefresh(d, ignoreTagsConfig) {
+		tags, err := ListTags(conn, aws.StringValue(describeResp.CacheParameterGroups[0].ARN))
 
-	if err != nil {
-		return fmt.Errorf("error listing tags for ElastiCache Parameter Group (%s): %w", d.Id(), err)
-	}
+		if err != nil {
+			return fmt.Errorf("error listing tags for ElastiCache Parameter Group (%s): %w", d.Id(), err)
+		}
 
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
-	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
-	}
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+			return fmt.Errorf("error setting tags: %w", err)
+		}
 
-	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all: %w", err)
+		}
 	}
 
 	// Only include user customized parameters as there's hundreds of system/default ones
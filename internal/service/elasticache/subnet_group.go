@@ -156,21 +156,23 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", group.CacheSubnetGroupDescription)
 	d.Set("subnet_ids", ids)
 
-	tags, err := ListTags(conn, d.Get("arn").(string))
+	if !skipTagsRefresh(d, ignoreTagsConfig) {
+		tags, err := ListTags(conn, d.Get("arn").(string))
 
-	if err != nil && !tfawserr.ErrMessageContains(err, "UnknownOperationException", "") {
-		return fmt.Errorf("error listing tags for ElastiCache SubnetGroup (%s): %w", d.Id(), err)
-	}
+		if err != nil && !tfawserr.ErrMessageContains(err, "UnknownOperationException", "") {
+			return fmt.Errorf("error listing tags for ElastiCache SubnetGroup (%s): %w", d.Id(), err)
+		}
 
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
-	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
-	}
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+			return fmt.Errorf("error setting tags: %w", err)
+		}
 
-	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all: %w", err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,20 @@
+package elasticache
+
+import (
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// skipTagsRefresh returns whether reading resource tags can be skipped, as
+// configured by the provider ignore_tags refresh_tags and no_refresh_services arguments.
+// Tags are always read when the state has no prior value, e.g. on import.
+func skipTagsRefresh(d *schema.ResourceData, ignoreTagsConfig *tftags.IgnoreConfig) bool {
+	if !ignoreTagsConfig.SkipRefresh(elasticache.EndpointsID) {
+		return false
+	}
+
+	_, ok := d.GetOkExists("tags_all")
+
+	return ok
+}
@@ -132,21 +132,23 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Tags are currently only supported in AWS Commercial.
 	if meta.(*conns.AWSClient).Partition == endpoints.AwsPartitionID {
-		tags, err := ListTags(conn, aws.StringValue(resp.ARN))
+		if !skipTagsRefresh(d, ignoreTagsConfig) {
+			tags, err := ListTags(conn, aws.StringValue(resp.ARN))
 
-		if err != nil {
-			return fmt.Errorf("error listing tags for ElastiCache User (%s): %w", aws.StringValue(resp.ARN), err)
-		}
+			if err != nil {
+				return fmt.Errorf("error listing tags for ElastiCache User (%s): %w", aws.StringValue(resp.ARN), err)
+			}
 
-		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+			tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
-		//lintignore:AWSR002
-		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-			return fmt.Errorf("error setting tags: %w", err)
-		}
+			//lintignore:AWSR002
+			if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+				return fmt.Errorf("error setting tags: %w", err)
+			}
 
-		if err := d.Set("tags_all", tags.Map()); err != nil {
-			return fmt.Errorf("error setting tags_all: %w", err)
+			if err := d.Set("tags_all", tags.Map()); err != nil {
+				return fmt.Errorf("error setting tags_all: %w", err)
+			}
 		}
 	} else {
 		d.Set("tags", nil)
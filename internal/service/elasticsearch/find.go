@@ -0,0 +1,76 @@
+package elasticsearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindInboundConnectionByID(conn *elasticsearch.ElasticsearchService, id string) (*elasticsearch.InboundCrossClusterSearchConnection, error) {
+	input := &elasticsearch.DescribeInboundCrossClusterSearchConnectionsInput{
+		Filters: []*elasticsearch.Filter{
+			{
+				Name:   aws.String("cross-cluster-search-connection-id"),
+				Values: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeInboundCrossClusterSearchConnections(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CrossClusterSearchConnections) == 0 || output.CrossClusterSearchConnections[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	connection := output.CrossClusterSearchConnections[0]
+
+	if connection.ConnectionStatus != nil {
+		if status := aws.StringValue(connection.ConnectionStatus.StatusCode); status == elasticsearch.InboundCrossClusterSearchConnectionStatusCodeDeleted || status == elasticsearch.InboundCrossClusterSearchConnectionStatusCodeRejected {
+			return nil, &resource.NotFoundError{
+				Message:     status,
+				LastRequest: input,
+			}
+		}
+	}
+
+	return connection, nil
+}
+
+func FindOutboundConnectionByID(conn *elasticsearch.ElasticsearchService, id string) (*elasticsearch.OutboundCrossClusterSearchConnection, error) {
+	input := &elasticsearch.DescribeOutboundCrossClusterSearchConnectionsInput{
+		Filters: []*elasticsearch.Filter{
+			{
+				Name:   aws.String("cross-cluster-search-connection-id"),
+				Values: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeOutboundCrossClusterSearchConnections(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CrossClusterSearchConnections) == 0 || output.CrossClusterSearchConnections[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	connection := output.CrossClusterSearchConnections[0]
+
+	if connection.ConnectionStatus != nil {
+		if status := aws.StringValue(connection.ConnectionStatus.StatusCode); status == elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeDeleted {
+			return nil, &resource.NotFoundError{
+				Message:     status,
+				LastRequest: input,
+			}
+		}
+	}
+
+	return connection, nil
+}
@@ -0,0 +1,113 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInboundConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInboundConnectionAccepterCreate,
+		Read:   resourceInboundConnectionAccepterRead,
+		Delete: resourceInboundConnectionAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("connection_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInboundConnectionAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticsearchConn
+
+	connectionID := d.Get("connection_id").(string)
+	input := &elasticsearch.AcceptInboundCrossClusterSearchConnectionInput{
+		CrossClusterSearchConnectionId: aws.String(connectionID),
+	}
+
+	log.Printf("[DEBUG] Accepting Elasticsearch Inbound Connection: %s", input)
+	_, err := conn.AcceptInboundCrossClusterSearchConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting Elasticsearch Inbound Connection (%s): %w", connectionID, err)
+	}
+
+	d.SetId(connectionID)
+
+	if _, err := waitInboundConnectionAccepted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Elasticsearch Inbound Connection (%s) accept: %w", d.Id(), err)
+	}
+
+	return resourceInboundConnectionAccepterRead(d, meta)
+}
+
+func resourceInboundConnectionAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticsearchConn
+
+	connection, err := FindInboundConnectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Inbound Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Elasticsearch Inbound Connection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("connection_id", connection.CrossClusterSearchConnectionId)
+	if connection.ConnectionStatus != nil {
+		d.Set("connection_status", connection.ConnectionStatus.StatusCode)
+	} else {
+		d.Set("connection_status", nil)
+	}
+
+	return nil
+}
+
+func resourceInboundConnectionAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticsearchConn
+
+	log.Printf("[DEBUG] Deleting Elasticsearch Inbound Connection: %s", d.Id())
+	_, err := conn.DeleteInboundCrossClusterSearchConnection(&elasticsearch.DeleteInboundCrossClusterSearchConnectionInput{
+		CrossClusterSearchConnectionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticsearch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Elasticsearch Inbound Connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitInboundConnectionDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Elasticsearch Inbound Connection (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package elasticsearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccElasticsearchInboundConnectionAccepter_basic(t *testing.T) {
+	ri := sdkacctest.RandString(10)
+	name := fmt.Sprintf("tf-test-%s", ri)
+	resourceName := "aws_elasticsearch_inbound_connection_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticsearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInboundConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInboundConnectionAccepterConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInboundConnectionAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_id", "aws_elasticsearch_outbound_connection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", elasticsearch.InboundCrossClusterSearchConnectionStatusCodeApproved),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInboundConnectionAccepterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Elasticsearch Inbound Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+
+		_, err := tfelasticsearch.FindInboundConnectionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInboundConnectionAccepterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticsearch_inbound_connection_accepter" {
+			continue
+		}
+
+		_, err := tfelasticsearch.FindInboundConnectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Elasticsearch Inbound Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInboundConnectionAccepterConfig(name string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionConfig(name), `
+resource "aws_elasticsearch_inbound_connection_accepter" "test" {
+  connection_id = aws_elasticsearch_outbound_connection.test.id
+}
+`)
+}
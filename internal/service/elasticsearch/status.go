@@ -0,0 +1,48 @@
+package elasticsearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusInboundConnection(conn *elasticsearch.ElasticsearchService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInboundConnectionByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.ConnectionStatus == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.ConnectionStatus.StatusCode), nil
+	}
+}
+
+func statusOutboundConnection(conn *elasticsearch.ElasticsearchService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOutboundConnectionByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.ConnectionStatus == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.ConnectionStatus.StatusCode), nil
+	}
+}
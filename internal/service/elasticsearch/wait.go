@@ -0,0 +1,120 @@
+package elasticsearch
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	inboundConnectionAcceptedTimeout = 1 * time.Minute
+	inboundConnectionDeletedTimeout  = 5 * time.Minute
+
+	outboundConnectionCreatedTimeout = 1 * time.Minute
+	outboundConnectionDeletedTimeout = 5 * time.Minute
+)
+
+func waitInboundConnectionAccepted(conn *elasticsearch.ElasticsearchService, id string) (*elasticsearch.InboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{elasticsearch.InboundCrossClusterSearchConnectionStatusCodePendingAcceptance},
+		Target:  []string{elasticsearch.InboundCrossClusterSearchConnectionStatusCodeApproved},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: inboundConnectionAcceptedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.InboundCrossClusterSearchConnection); ok {
+		if status := output.ConnectionStatus; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionDeleted(conn *elasticsearch.ElasticsearchService, id string) (*elasticsearch.InboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodeApproved,
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodeDeleting,
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodeRejecting,
+		},
+		Target:  []string{},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: inboundConnectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.InboundCrossClusterSearchConnection); ok {
+		if status := output.ConnectionStatus; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutboundConnectionCreated(conn *elasticsearch.ElasticsearchService, id string) (*elasticsearch.OutboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeValidating,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeProvisioning,
+		},
+		Target: []string{
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeActive,
+		},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: outboundConnectionCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.OutboundCrossClusterSearchConnection); ok {
+		if status := output.ConnectionStatus; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutboundConnectionDeleted(conn *elasticsearch.ElasticsearchService, id string) (*elasticsearch.OutboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeActive,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeDeleting,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeRejected,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeValidationFailed,
+		},
+		Target:  []string{},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: outboundConnectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.OutboundCrossClusterSearchConnection); ok {
+		if status := output.ConnectionStatus; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
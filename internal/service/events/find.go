@@ -104,3 +104,31 @@ func FindTarget(conn *eventbridge.EventBridge, busName, ruleName, targetId strin
 	}
 	return result, nil
 }
+
+func FindReplayByName(conn *eventbridge.EventBridge, name string) (*eventbridge.DescribeReplayOutput, error) {
+	input := &eventbridge.DescribeReplayInput{
+		ReplayName: aws.String(name),
+	}
+
+	output, err := conn.DescribeReplay(input)
+
+	if tfawserr.ErrCodeEquals(err, eventbridge.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=ListEventBuses,ListRules,ListTargetsByRule"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListApiDestinations,ListArchives,ListConnections,ListEventBuses,ListReplays,ListRules,ListTargetsByRule"; DO NOT EDIT.
 
 package events
 
@@ -9,6 +9,69 @@ import (
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 )
 
+func listAPIDestinationsPages(conn *eventbridge.EventBridge, input *eventbridge.ListApiDestinationsInput, fn func(*eventbridge.ListApiDestinationsOutput, bool) bool) error {
+	return listAPIDestinationsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func listAPIDestinationsPagesWithContext(ctx context.Context, conn *eventbridge.EventBridge, input *eventbridge.ListApiDestinationsInput, fn func(*eventbridge.ListApiDestinationsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListApiDestinationsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func listArchivesPages(conn *eventbridge.EventBridge, input *eventbridge.ListArchivesInput, fn func(*eventbridge.ListArchivesOutput, bool) bool) error {
+	return listArchivesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func listArchivesPagesWithContext(ctx context.Context, conn *eventbridge.EventBridge, input *eventbridge.ListArchivesInput, fn func(*eventbridge.ListArchivesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListArchivesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func listConnectionsPages(conn *eventbridge.EventBridge, input *eventbridge.ListConnectionsInput, fn func(*eventbridge.ListConnectionsOutput, bool) bool) error {
+	return listConnectionsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func listConnectionsPagesWithContext(ctx context.Context, conn *eventbridge.EventBridge, input *eventbridge.ListConnectionsInput, fn func(*eventbridge.ListConnectionsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListConnectionsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
 func listEventBusesPages(conn *eventbridge.EventBridge, input *eventbridge.ListEventBusesInput, fn func(*eventbridge.ListEventBusesOutput, bool) bool) error {
 	return listEventBusesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -30,6 +93,27 @@ func listEventBusesPagesWithContext(ctx context.Context, conn *eventbridge.Event
 	return nil
 }
 
+func listReplaysPages(conn *eventbridge.EventBridge, input *eventbridge.ListReplaysInput, fn func(*eventbridge.ListReplaysOutput, bool) bool) error {
+	return listReplaysPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func listReplaysPagesWithContext(ctx context.Context, conn *eventbridge.EventBridge, input *eventbridge.ListReplaysInput, fn func(*eventbridge.ListReplaysOutput, bool) bool) error {
+	for {
+		output, err := conn.ListReplaysWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
 func listRulesPages(conn *eventbridge.EventBridge, input *eventbridge.ListRulesInput, fn func(*eventbridge.ListRulesOutput, bool) bool) error {
 	return listRulesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -0,0 +1,139 @@
+package events_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEventsReplay_basic(t *testing.T) {
+	var v eventbridge.DescribeReplayOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_replay.test"
+	busResourceName := "aws_cloudwatch_event_bus.test"
+	archiveResourceName := "aws_cloudwatch_event_archive.test"
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplayConfig(rName, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplayExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("replay/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.arn", busResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.filter_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "event_end_time", endTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", archiveResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "event_start_time", startTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", eventbridge.ReplayStateCompleted),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"state", "replay_end_time", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccCheckReplayDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_replay" {
+			continue
+		}
+
+		output, err := tfevents.FindReplayByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Replays cannot be deleted, only cancelled while in progress.
+		switch state := aws.StringValue(output.State); state {
+		case eventbridge.ReplayStateCompleted, eventbridge.ReplayStateCancelled, eventbridge.ReplayStateFailed:
+			continue
+		default:
+			return fmt.Errorf("EventBridge Replay %s still in progress (%s)", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckReplayExists(n string, v *eventbridge.DescribeReplayOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Replay ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+
+		output, err := tfevents.FindReplayByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReplayConfig(rName, startTime, endTime string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+}
+
+resource "aws_cloudwatch_event_replay" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_archive.test.arn
+  event_start_time = %[2]q
+  event_end_time   = %[3]q
+
+  destination {
+    arn = aws_cloudwatch_event_bus.test.arn
+  }
+
+  wait_for_completion = true
+}
+`, rName, startTime, endTime)
+}
@@ -30,6 +30,7 @@ func init() {
 		F:    sweepArchives,
 		Dependencies: []string{
 			"aws_cloudwatch_event_bus",
+			"aws_cloudwatch_event_replay",
 		},
 	})
 
@@ -53,6 +54,11 @@ func init() {
 		F:    sweepPermissions,
 	})
 
+	resource.AddTestSweepers("aws_cloudwatch_event_replay", &resource.Sweeper{
+		Name: "aws_cloudwatch_event_replay",
+		F:    sweepReplays,
+	})
+
 	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
@@ -80,24 +86,23 @@ func sweepAPIDestination(region string) error {
 		Limit: aws.Int64(100),
 	}
 	var apiDestinations []*eventbridge.ApiDestination
-	for {
-		output, err := conn.ListApiDestinations(input)
-
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge API Destination sweep for %s: %s", region, err)
-			return nil
+	err = listAPIDestinationsPages(conn, input, func(page *eventbridge.ListApiDestinationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		if err != nil {
-			return fmt.Errorf("Error retrieving EventBridge API Destinations: %w", err)
-		}
+		apiDestinations = append(apiDestinations, page.ApiDestinations...)
+
+		return !lastPage
+	})
 
-		apiDestinations = append(apiDestinations, output.ApiDestinations...)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EventBridge API Destination sweep for %s: %s", region, err)
+		return nil
+	}
 
-		if aws.StringValue(output.NextToken) == "" {
-			break
-		}
-		input.NextToken = output.NextToken
+	if err != nil {
+		return fmt.Errorf("Error retrieving EventBridge API Destinations: %w", err)
 	}
 
 	for _, apiDestination := range apiDestinations {
@@ -125,25 +130,14 @@ func sweepArchives(region string) error {
 	conn := client.(*conns.AWSClient).EventsConn
 
 	input := &eventbridge.ListArchivesInput{}
+	var sweeperErrs *multierror.Error
 
-	for {
-		output, err := conn.ListArchives(input)
-
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge archive sweep for %s: %s", region, err)
-			return nil
-		}
-
-		if err != nil {
-			return fmt.Errorf("Error retrieving EventBridge archive: %w", err)
-		}
-
-		if len(output.Archives) == 0 {
-			log.Print("[DEBUG] No EventBridge archives to sweep")
-			return nil
+	err = listArchivesPages(conn, input, func(page *eventbridge.ListArchivesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, archive := range output.Archives {
+		for _, archive := range page.Archives {
 			name := aws.StringValue(archive.ArchiveName)
 			if name == "default" {
 				continue
@@ -154,17 +148,24 @@ func sweepArchives(region string) error {
 				ArchiveName: aws.String(name),
 			})
 			if err != nil {
-				return fmt.Errorf("Error deleting EventBridge archive (%s): %w", name, err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge archive (%s): %w", name, err))
+				continue
 			}
 		}
 
-		if output.NextToken == nil {
-			break
-		}
-		input.NextToken = output.NextToken
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EventBridge archive sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
-	return nil
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving EventBridge archive: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepBuses(region string) error {
@@ -227,22 +228,23 @@ func sweepConnection(region string) error {
 		Limit: aws.Int64(100),
 	}
 	var connections []*eventbridge.Connection
-	for {
-		output, err := conn.ListConnections(input)
-
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge Connection sweep for %s: %s", region, err)
-			return nil
+	err = listConnectionsPages(conn, input, func(page *eventbridge.ListConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		if err != nil {
-			return fmt.Errorf("Error retrieving EventBridge Connections: %w", err)
-		}
+		connections = append(connections, page.Connections...)
 
-		if aws.StringValue(output.NextToken) == "" {
-			break
-		}
-		input.NextToken = output.NextToken
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EventBridge Connection sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving EventBridge Connections: %w", err)
 	}
 
 	for _, connection := range connections {
@@ -305,6 +307,49 @@ func sweepPermissions(region string) error {
 	return nil
 }
 
+func sweepReplays(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EventsConn
+	input := &eventbridge.ListReplaysInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = listReplaysPages(conn, input, func(page *eventbridge.ListReplaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, replay := range page.Replays {
+			r := ResourceReplay()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(replay.ReplayName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EventBridge Replay sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EventBridge Replays (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EventBridge Replays (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepRules(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
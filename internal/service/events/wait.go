@@ -1,16 +1,21 @@
 package events
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	connectionCreatedTimeout = 2 * time.Minute
 	connectionDeletedTimeout = 2 * time.Minute
 	connectionUpdatedTimeout = 2 * time.Minute
+
+	replayCancelledTimeout = 10 * time.Minute
 )
 
 func waitConnectionCreated(conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeConnectionOutput, error) {
@@ -63,3 +68,41 @@ func waitConnectionUpdated(conn *eventbridge.EventBridge, id string) (*eventbrid
 
 	return nil, err
 }
+
+func waitReplayCompleted(conn *eventbridge.EventBridge, name string, timeout time.Duration) (*eventbridge.DescribeReplayOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.ReplayStateStarting, eventbridge.ReplayStateRunning},
+		Target:  []string{eventbridge.ReplayStateCompleted},
+		Refresh: statusReplayState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eventbridge.DescribeReplayOutput); ok {
+		if state := aws.StringValue(output.State); state == eventbridge.ReplayStateCancelled || state == eventbridge.ReplayStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplayCancelled(conn *eventbridge.EventBridge, name string) (*eventbridge.DescribeReplayOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.ReplayStateCancelling, eventbridge.ReplayStateStarting, eventbridge.ReplayStateRunning},
+		Target:  []string{eventbridge.ReplayStateCancelled, eventbridge.ReplayStateCompleted, eventbridge.ReplayStateFailed},
+		Refresh: statusReplayState(conn, name),
+		Timeout: replayCancelledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eventbridge.DescribeReplayOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -69,6 +69,7 @@ func dynamicPartitioningConfigurationSchema() *schema.Schema {
 					Type:     schema.TypeBool,
 					Optional: true,
 					Default:  false,
+					ForceNew: true,
 				},
 				"retry_duration": {
 					Type:         schema.TypeInt,
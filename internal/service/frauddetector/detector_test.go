@@ -0,0 +1,216 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorDetector_basic(t *testing.T) {
+	var v frauddetector.Detector
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("detector/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "detector_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "event_type_name", "aws_frauddetector_event_type.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorConfigDescription(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorDetector_tags(t *testing.T) {
+	var v frauddetector.Detector
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDetectorConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorDetector_disappears(t *testing.T) {
+	var v frauddetector.Detector
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_detector" {
+			continue
+		}
+
+		_, err := tffrauddetector.FindDetectorByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Detector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDetectorExists(n string, v *frauddetector.Detector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Detector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindDetectorByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDetectorConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName))
+}
+
+func testAccDetectorConfigDescription(rName, description string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  description     = %[2]q
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName, description))
+}
+
+func testAccDetectorConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDetectorConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
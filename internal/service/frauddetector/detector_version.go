@@ -0,0 +1,436 @@
+package frauddetector
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDetectorVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDetectorVersionCreate,
+		Read:   resourceDetectorVersionRead,
+		Update: resourceDetectorVersionUpdate,
+		Delete: resourceDetectorVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"detector_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_model_endpoints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"model_version": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"model_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"model_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(frauddetector.ModelTypeEnum_Values(), false),
+						},
+						"model_version_number": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 7),
+						},
+					},
+				},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"detector_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"rule_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"rule_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 5),
+						},
+					},
+				},
+			},
+			"rule_execution_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.RuleExecutionMode_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      frauddetector.DetectorVersionStatusDraft,
+				ValidateFunc: validation.StringInSlice(frauddetector.DetectorVersionStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDetectorVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	detectorID := d.Get("detector_id").(string)
+	input := &frauddetector.CreateDetectorVersionInput{
+		DetectorId: aws.String(detectorID),
+		Rules:      expandRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("external_model_endpoints"); ok && len(v.([]interface{})) > 0 {
+		input.ExternalModelEndpoints = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("model_version"); ok && len(v.([]interface{})) > 0 {
+		input.ModelVersions = expandModelVersions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("rule_execution_mode"); ok {
+		input.RuleExecutionMode = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Detector Version: %s", input)
+	output, err := conn.CreateDetectorVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Detector (%s) Version: %w", detectorID, err)
+	}
+
+	d.SetId(DetectorVersionCreateResourceID(detectorID, aws.StringValue(output.DetectorVersionId)))
+
+	// New detector versions are always created in DRAFT status.
+	if v := d.Get("status").(string); v != aws.StringValue(output.Status) {
+		if err := updateDetectorVersionStatus(conn, detectorID, aws.StringValue(output.DetectorVersionId), v); err != nil {
+			return fmt.Errorf("error setting Fraud Detector Detector Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDetectorVersionRead(d, meta)
+}
+
+func resourceDetectorVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	detectorID, detectorVersionID, err := DetectorVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindDetectorVersionByTwoPartKey(conn, detectorID, detectorVersionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Detector Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Detector Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("detector_id", output.DetectorId)
+	d.Set("detector_version_id", output.DetectorVersionId)
+	d.Set("external_model_endpoints", aws.StringValueSlice(output.ExternalModelEndpoints))
+	if err := d.Set("model_version", flattenModelVersions(output.ModelVersions)); err != nil {
+		return fmt.Errorf("error setting model_version: %w", err)
+	}
+	if err := d.Set("rule", flattenRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+	d.Set("rule_execution_mode", output.RuleExecutionMode)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Detector Version (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDetectorVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	detectorID, detectorVersionID, err := DetectorVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Only DRAFT detector versions can have their rules and models changed.
+	if d.HasChanges("external_model_endpoints", "model_version", "rule", "rule_execution_mode") {
+		input := &frauddetector.UpdateDetectorVersionInput{
+			DetectorId:             aws.String(detectorID),
+			DetectorVersionId:      aws.String(detectorVersionID),
+			ExternalModelEndpoints: flex.ExpandStringList(d.Get("external_model_endpoints").([]interface{})),
+			ModelVersions:          expandModelVersions(d.Get("model_version").([]interface{})),
+			Rules:                  expandRules(d.Get("rule").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("rule_execution_mode"); ok {
+			input.RuleExecutionMode = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Detector Version: %s", input)
+		_, err := conn.UpdateDetectorVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Detector Version (%s): %w", d.Id(), err)
+		}
+	} else if d.HasChange("description") {
+		input := &frauddetector.UpdateDetectorVersionMetadataInput{
+			Description:       aws.String(d.Get("description").(string)),
+			DetectorId:        aws.String(detectorID),
+			DetectorVersionId: aws.String(detectorVersionID),
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Detector Version metadata: %s", input)
+		_, err := conn.UpdateDetectorVersionMetadata(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Detector Version (%s) metadata: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := updateDetectorVersionStatus(conn, detectorID, detectorVersionID, d.Get("status").(string)); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Detector Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Detector Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDetectorVersionRead(d, meta)
+}
+
+func resourceDetectorVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	detectorID, detectorVersionID, err := DetectorVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// ACTIVE detector versions must be deactivated before they can be deleted.
+	if d.Get("status").(string) == frauddetector.DetectorVersionStatusActive {
+		err := updateDetectorVersionStatus(conn, detectorID, detectorVersionID, frauddetector.DetectorVersionStatusInactive)
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deactivating Fraud Detector Detector Version (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Detector Version: %s", d.Id())
+	_, err = conn.DeleteDetectorVersion(&frauddetector.DeleteDetectorVersionInput{
+		DetectorId:        aws.String(detectorID),
+		DetectorVersionId: aws.String(detectorVersionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Detector Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateDetectorVersionStatus(conn *frauddetector.FraudDetector, detectorID, detectorVersionID, status string) error {
+	input := &frauddetector.UpdateDetectorVersionStatusInput{
+		DetectorId:        aws.String(detectorID),
+		DetectorVersionId: aws.String(detectorVersionID),
+		Status:            aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Fraud Detector Detector Version status: %s", input)
+	_, err := conn.UpdateDetectorVersionStatus(input)
+
+	return err
+}
+
+func expandRules(tfList []interface{}) []*frauddetector.Rule {
+	var apiObjects []*frauddetector.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &frauddetector.Rule{
+			DetectorId:  aws.String(tfMap["detector_id"].(string)),
+			RuleId:      aws.String(tfMap["rule_id"].(string)),
+			RuleVersion: aws.String(tfMap["rule_version"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandModelVersions(tfList []interface{}) []*frauddetector.ModelVersion {
+	var apiObjects []*frauddetector.ModelVersion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &frauddetector.ModelVersion{
+			ModelId:            aws.String(tfMap["model_id"].(string)),
+			ModelType:          aws.String(tfMap["model_type"].(string)),
+			ModelVersionNumber: aws.String(tfMap["model_version_number"].(string)),
+		}
+
+		if v, ok := tfMap["arn"].(string); ok && v != "" {
+			apiObject.Arn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRules(apiObjects []*frauddetector.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"detector_id":  aws.StringValue(apiObject.DetectorId),
+			"rule_id":      aws.StringValue(apiObject.RuleId),
+			"rule_version": aws.StringValue(apiObject.RuleVersion),
+		})
+	}
+
+	return tfList
+}
+
+func flattenModelVersions(apiObjects []*frauddetector.ModelVersion) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                  aws.StringValue(apiObject.Arn),
+			"model_id":             aws.StringValue(apiObject.ModelId),
+			"model_type":           aws.StringValue(apiObject.ModelType),
+			"model_version_number": aws.StringValue(apiObject.ModelVersionNumber),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,164 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorDetectorVersion_basic(t *testing.T) {
+	var v frauddetector.GetDetectorVersionOutput
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_detector_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorVersionConfig(rName, frauddetector.DetectorVersionStatusDraft),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorVersionExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("detector-version/%s/1", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_frauddetector_detector.test", "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "detector_version_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "model_version.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.rule_id", "aws_frauddetector_rule.test", "rule_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.rule_version", "aws_frauddetector_rule.test", "rule_version"),
+					resource.TestCheckResourceAttr(resourceName, "rule_execution_mode", frauddetector.RuleExecutionModeFirstMatched),
+					resource.TestCheckResourceAttr(resourceName, "status", frauddetector.DetectorVersionStatusDraft),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorVersionConfig(rName, frauddetector.DetectorVersionStatusActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", frauddetector.DetectorVersionStatusActive),
+				),
+			},
+			{
+				Config: testAccDetectorVersionConfig(rName, frauddetector.DetectorVersionStatusInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", frauddetector.DetectorVersionStatusInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorDetectorVersion_disappears(t *testing.T) {
+	var v frauddetector.GetDetectorVersionOutput
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_detector_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorVersionConfig(rName, frauddetector.DetectorVersionStatusDraft),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorVersionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceDetectorVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_detector_version" {
+			continue
+		}
+
+		detectorID, detectorVersionID, err := tffrauddetector.DetectorVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tffrauddetector.FindDetectorVersionByTwoPartKey(conn, detectorID, detectorVersionID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Detector Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDetectorVersionExists(n string, v *frauddetector.GetDetectorVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Detector Version ID is set")
+		}
+
+		detectorID, detectorVersionID, err := tffrauddetector.DetectorVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindDetectorVersionByTwoPartKey(conn, detectorID, detectorVersionID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDetectorVersionConfig(rName, status string) string {
+	return acctest.ConfigCompose(testAccRuleConfig(rName, "unknown"), fmt.Sprintf(`
+resource "aws_frauddetector_detector_version" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  status      = %[1]q
+
+  rule {
+    detector_id  = aws_frauddetector_rule.test.detector_id
+    rule_id      = aws_frauddetector_rule.test.rule_id
+    rule_version = aws_frauddetector_rule.test.rule_version
+  }
+}
+`, status))
+}
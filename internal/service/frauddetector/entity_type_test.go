@@ -0,0 +1,211 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorEntityType_basic(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("entity-type/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityTypeConfigDescription(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEntityType_tags(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityTypeConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityTypeConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEntityTypeConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEntityType_disappears(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceEntityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntityTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_entity_type" {
+			continue
+		}
+
+		_, err := tffrauddetector.FindEntityTypeByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Entity Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEntityTypeExists(n string, v *frauddetector.EntityType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Entity Type ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindEntityTypeByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEntityTypeConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccEntityTypeConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccEntityTypeConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccEntityTypeConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
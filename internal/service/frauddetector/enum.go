@@ -0,0 +1,14 @@
+package frauddetector
+
+// Model version statuses are returned as free-form strings by the API.
+// https://docs.aws.amazon.com/frauddetector/latest/api/API_GetModelVersion.html#FraudDetector-GetModelVersion-response-status
+const (
+	modelVersionStatusActivateInProgress   = "ACTIVATE_IN_PROGRESS"
+	modelVersionStatusActivateRequested    = "ACTIVATE_REQUESTED"
+	modelVersionStatusActive               = "ACTIVE"
+	modelVersionStatusInactivateInProgress = "INACTIVATE_IN_PROGRESS"
+	modelVersionStatusInactivateRequested  = "INACTIVATE_REQUESTED"
+	modelVersionStatusInactive             = "INACTIVE"
+	modelVersionStatusTrainingComplete     = "TRAINING_COMPLETE"
+	modelVersionStatusTrainingInProgress   = "TRAINING_IN_PROGRESS"
+)
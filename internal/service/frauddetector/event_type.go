@@ -0,0 +1,216 @@
+package frauddetector
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEventType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEventTypeCreate,
+		Read:   resourceEventTypeRead,
+		Update: resourceEventTypeUpdate,
+		Delete: resourceEventTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"entity_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_ingestion": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.EventIngestion_Values(), false),
+			},
+			"event_variables": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEventTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := expandPutEventTypeInput(d)
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Event Type: %s", input)
+	_, err := conn.PutEventType(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Event Type (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEventTypeRead(d, meta)
+}
+
+func resourceEventTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEventTypeByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Event Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Event Type (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("entity_types", aws.StringValueSlice(output.EntityTypes))
+	d.Set("event_ingestion", output.EventIngestion)
+	d.Set("event_variables", aws.StringValueSlice(output.EventVariables))
+	d.Set("labels", aws.StringValueSlice(output.Labels))
+	d.Set("name", output.Name)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Event Type (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEventTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := expandPutEventTypeInput(d)
+
+		log.Printf("[DEBUG] Updating Fraud Detector Event Type: %s", input)
+		_, err := conn.PutEventType(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Event Type (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Event Type (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEventTypeRead(d, meta)
+}
+
+func resourceEventTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Event Type: %s", d.Id())
+	_, err := conn.DeleteEventType(&frauddetector.DeleteEventTypeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Event Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// PutEventType is used for both create and update and requires the full event type definition.
+func expandPutEventTypeInput(d *schema.ResourceData) *frauddetector.PutEventTypeInput {
+	input := &frauddetector.PutEventTypeInput{
+		EntityTypes:    flex.ExpandStringSet(d.Get("entity_types").(*schema.Set)),
+		EventVariables: flex.ExpandStringSet(d.Get("event_variables").(*schema.Set)),
+		Name:           aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_ingestion"); ok {
+		input.EventIngestion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("labels"); ok && v.(*schema.Set).Len() > 0 {
+		input.Labels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	return input
+}
@@ -0,0 +1,189 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorEventType_basic(t *testing.T) {
+	var v frauddetector.EventType
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTypeExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("event-type/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "entity_types.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entity_types.*", "aws_frauddetector_entity_type.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "event_ingestion", frauddetector.EventIngestionDisabled),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "event_variables.*", "aws_frauddetector_variable.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventTypeConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "event_ingestion", frauddetector.EventIngestionEnabled),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEventType_disappears(t *testing.T) {
+	var v frauddetector.EventType
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTypeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceEventType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_event_type" {
+			continue
+		}
+
+		_, err := tffrauddetector.FindEventTypeByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Event Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEventTypeExists(n string, v *frauddetector.EventType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Event Type ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindEventTypeByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccEventTypeConfigBase creates the entity type, variables and labels an event type requires.
+func testAccEventTypeConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = "%[1]s_customer"
+}
+
+resource "aws_frauddetector_variable" "test" {
+  name          = "%[1]s_email_address"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "EMAIL_ADDRESS"
+}
+
+resource "aws_frauddetector_variable" "test2" {
+  name          = "%[1]s_ip_address"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "IP_ADDRESS"
+}
+
+resource "aws_frauddetector_label" "fraud" {
+  name = "%[1]s_fraud"
+}
+
+resource "aws_frauddetector_label" "legit" {
+  name = "%[1]s_legit"
+}
+`, rName)
+}
+
+func testAccEventTypeConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.test.name]
+  labels          = [aws_frauddetector_label.fraud.name, aws_frauddetector_label.legit.name]
+}
+`, rName))
+}
+
+func testAccEventTypeConfigUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  description     = "updated"
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_ingestion = "ENABLED"
+  event_variables = [aws_frauddetector_variable.test.name, aws_frauddetector_variable.test2.name]
+  labels          = [aws_frauddetector_label.fraud.name, aws_frauddetector_label.legit.name]
+}
+`, rName))
+}
@@ -0,0 +1,82 @@
+package frauddetector
+
+import (
+	"fmt"
+	"strings"
+)
+
+const detectorVersionResourceIDSeparator = "/"
+
+func DetectorVersionCreateResourceID(detectorID, detectorVersionID string) string {
+	parts := []string{detectorID, detectorVersionID}
+	id := strings.Join(parts, detectorVersionResourceIDSeparator)
+
+	return id
+}
+
+func DetectorVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, detectorVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTORID%[2]sDETECTORVERSIONID", id, detectorVersionResourceIDSeparator)
+}
+
+const modelResourceIDSeparator = "/"
+
+func ModelCreateResourceID(modelID, modelType string) string {
+	parts := []string{modelID, modelType}
+	id := strings.Join(parts, modelResourceIDSeparator)
+
+	return id
+}
+
+func ModelParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, modelResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MODELID%[2]sMODELTYPE", id, modelResourceIDSeparator)
+}
+
+const modelVersionResourceIDSeparator = "/"
+
+func ModelVersionCreateResourceID(modelID, modelType, modelVersionNumber string) string {
+	parts := []string{modelID, modelType, modelVersionNumber}
+	id := strings.Join(parts, modelVersionResourceIDSeparator)
+
+	return id
+}
+
+func ModelVersionParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, modelVersionResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MODELID%[2]sMODELTYPE%[2]sMODELVERSIONNUMBER", id, modelVersionResourceIDSeparator)
+}
+
+const ruleResourceIDSeparator = "/"
+
+func RuleCreateResourceID(detectorID, ruleID string) string {
+	parts := []string{detectorID, ruleID}
+	id := strings.Join(parts, ruleResourceIDSeparator)
+
+	return id
+}
+
+func RuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ruleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTORID%[2]sRULEID", id, ruleResourceIDSeparator)
+}
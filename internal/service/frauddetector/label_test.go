@@ -0,0 +1,211 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorLabel_basic(t *testing.T) {
+	var v frauddetector.Label
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLabelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLabelExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("label/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLabelConfigDescription(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorLabel_tags(t *testing.T) {
+	var v frauddetector.Label
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLabelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLabelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLabelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorLabel_disappears(t *testing.T) {
+	var v frauddetector.Label
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLabelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLabelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceLabel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLabelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_label" {
+			continue
+		}
+
+		_, err := tffrauddetector.FindLabelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Label %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLabelExists(n string, v *frauddetector.Label) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Label ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindLabelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLabelConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccLabelConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccLabelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccLabelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,159 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorModel_basic(t *testing.T) {
+	var v frauddetector.Model
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("model/%s/%s", frauddetector.ModelTypeEnumOnlineFraudInsights, rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "event_type_name", "aws_frauddetector_event_type.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "model_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "model_type", frauddetector.ModelTypeEnumOnlineFraudInsights),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelConfigDescription(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorModel_disappears(t *testing.T) {
+	var v frauddetector.Model
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_model" {
+			continue
+		}
+
+		modelID, modelType, err := tffrauddetector.ModelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tffrauddetector.FindModelByTwoPartKey(conn, modelID, modelType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckModelExists(n string, v *frauddetector.Model) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Model ID is set")
+		}
+
+		modelID, modelType, err := tffrauddetector.ModelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindModelByTwoPartKey(conn, modelID, modelType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_model" "test" {
+  model_id        = %[1]q
+  model_type      = "ONLINE_FRAUD_INSIGHTS"
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName))
+}
+
+func testAccModelConfigDescription(rName, description string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_model" "test" {
+  model_id        = %[1]q
+  model_type      = "ONLINE_FRAUD_INSIGHTS"
+  description     = %[2]q
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName, description))
+}
@@ -0,0 +1,530 @@
+package frauddetector
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceModelVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceModelVersionCreate,
+		Read:   resourceModelVersionRead,
+		Update: resourceModelVersionUpdate,
+		Delete: resourceModelVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_events_detail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"data_location": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+					},
+				},
+				ExactlyOneOf: []string{"external_events_detail", "ingested_events_detail"},
+			},
+			"ingested_events_detail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ingested_events_time_window": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"external_events_detail", "ingested_events_detail"},
+			},
+			"model_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"model_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.ModelTypeEnum_Values(), false),
+			},
+			"model_version_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					modelVersionStatusActive,
+					modelVersionStatusInactive,
+				}, false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"training_data_schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label_schema": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label_mapper": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"label": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"unlabeled_events_treatment": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(frauddetector.UnlabeledEventsTreatment_Values(), false),
+									},
+								},
+							},
+						},
+						"model_variables": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"training_data_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.TrainingDataSourceEnum_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceModelVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	modelID := d.Get("model_id").(string)
+	modelType := d.Get("model_type").(string)
+	input := &frauddetector.CreateModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		TrainingDataSchema: expandTrainingDataSchema(d.Get("training_data_schema").([]interface{})),
+		TrainingDataSource: aws.String(d.Get("training_data_source").(string)),
+	}
+
+	if v, ok := d.GetOk("external_events_detail"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExternalEventsDetail = expandExternalEventsDetail(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("ingested_events_detail"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IngestedEventsDetail = expandIngestedEventsDetail(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Model Version: %s", input)
+	output, err := conn.CreateModelVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Model (%s) Version: %w", ModelCreateResourceID(modelID, modelType), err)
+	}
+
+	modelVersionNumber := aws.StringValue(output.ModelVersionNumber)
+	d.SetId(ModelVersionCreateResourceID(modelID, modelType, modelVersionNumber))
+
+	if _, err := waitModelVersionTrained(conn, modelID, modelType, modelVersionNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Fraud Detector Model Version (%s) training: %w", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		if err := updateModelVersionStatus(conn, modelID, modelType, modelVersionNumber, v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error setting Fraud Detector Model Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceModelVersionRead(d, meta)
+}
+
+func resourceModelVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	modelID, modelType, modelVersionNumber, err := ModelVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindModelVersionByThreePartKey(conn, modelID, modelType, modelVersionNumber)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Model Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Model Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if output.ExternalEventsDetail != nil {
+		if err := d.Set("external_events_detail", []interface{}{flattenExternalEventsDetail(output.ExternalEventsDetail)}); err != nil {
+			return fmt.Errorf("error setting external_events_detail: %w", err)
+		}
+	} else {
+		d.Set("external_events_detail", nil)
+	}
+	if output.IngestedEventsDetail != nil {
+		if err := d.Set("ingested_events_detail", []interface{}{flattenIngestedEventsDetail(output.IngestedEventsDetail)}); err != nil {
+			return fmt.Errorf("error setting ingested_events_detail: %w", err)
+		}
+	} else {
+		d.Set("ingested_events_detail", nil)
+	}
+	d.Set("model_id", output.ModelId)
+	d.Set("model_type", output.ModelType)
+	d.Set("model_version_number", output.ModelVersionNumber)
+	d.Set("status", output.Status)
+	if output.TrainingDataSchema != nil {
+		if err := d.Set("training_data_schema", []interface{}{flattenTrainingDataSchema(output.TrainingDataSchema)}); err != nil {
+			return fmt.Errorf("error setting training_data_schema: %w", err)
+		}
+	} else {
+		d.Set("training_data_schema", nil)
+	}
+	d.Set("training_data_source", output.TrainingDataSource)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Model Version (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceModelVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	if d.HasChange("status") {
+		modelID, modelType, modelVersionNumber, err := ModelVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := updateModelVersionStatus(conn, modelID, modelType, modelVersionNumber, d.Get("status").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Model Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Model Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceModelVersionRead(d, meta)
+}
+
+func resourceModelVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	modelID, modelType, modelVersionNumber, err := ModelVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// ACTIVE model versions must be deactivated before they can be deleted.
+	if d.Get("status").(string) == modelVersionStatusActive {
+		err := updateModelVersionStatus(conn, modelID, modelType, modelVersionNumber, modelVersionStatusInactive, d.Timeout(schema.TimeoutDelete))
+
+		if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deactivating Fraud Detector Model Version (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Model Version: %s", d.Id())
+	_, err = conn.DeleteModelVersion(&frauddetector.DeleteModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Model Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateModelVersionStatus(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber, status string, timeout time.Duration) error {
+	input := &frauddetector.UpdateModelVersionStatusInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+		Status:             aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Fraud Detector Model Version status: %s", input)
+	_, err := conn.UpdateModelVersionStatus(input)
+
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case modelVersionStatusActive:
+		_, err = waitModelVersionActivated(conn, modelID, modelType, modelVersionNumber, timeout)
+	case modelVersionStatusInactive:
+		_, err = waitModelVersionDeactivated(conn, modelID, modelType, modelVersionNumber, timeout)
+	}
+
+	return err
+}
+
+func expandTrainingDataSchema(tfList []interface{}) *frauddetector.TrainingDataSchema {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &frauddetector.TrainingDataSchema{}
+
+	if v, ok := tfMap["label_schema"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LabelSchema = expandLabelSchema(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["model_variables"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ModelVariables = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandLabelSchema(tfMap map[string]interface{}) *frauddetector.LabelSchema {
+	apiObject := &frauddetector.LabelSchema{
+		LabelMapper: map[string][]*string{},
+	}
+
+	if v, ok := tfMap["label_mapper"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.LabelMapper[tfMap["label"].(string)] = flex.ExpandStringSet(tfMap["values"].(*schema.Set))
+		}
+	}
+
+	if v, ok := tfMap["unlabeled_events_treatment"].(string); ok && v != "" {
+		apiObject.UnlabeledEventsTreatment = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandExternalEventsDetail(tfMap map[string]interface{}) *frauddetector.ExternalEventsDetail {
+	apiObject := &frauddetector.ExternalEventsDetail{}
+
+	if v, ok := tfMap["data_access_role_arn"].(string); ok && v != "" {
+		apiObject.DataAccessRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_location"].(string); ok && v != "" {
+		apiObject.DataLocation = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIngestedEventsDetail(tfMap map[string]interface{}) *frauddetector.IngestedEventsDetail {
+	apiObject := &frauddetector.IngestedEventsDetail{}
+
+	if v, ok := tfMap["ingested_events_time_window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.IngestedEventsTimeWindow = &frauddetector.IngestedEventsTimeWindow{
+			EndTime:   aws.String(tfMap["end_time"].(string)),
+			StartTime: aws.String(tfMap["start_time"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTrainingDataSchema(apiObject *frauddetector.TrainingDataSchema) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"model_variables": aws.StringValueSlice(apiObject.ModelVariables),
+	}
+
+	if v := apiObject.LabelSchema; v != nil {
+		var labelMapper []interface{}
+
+		for label, values := range v.LabelMapper {
+			labelMapper = append(labelMapper, map[string]interface{}{
+				"label":  label,
+				"values": aws.StringValueSlice(values),
+			})
+		}
+
+		tfMap["label_schema"] = []interface{}{map[string]interface{}{
+			"label_mapper":               labelMapper,
+			"unlabeled_events_treatment": aws.StringValue(v.UnlabeledEventsTreatment),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenExternalEventsDetail(apiObject *frauddetector.ExternalEventsDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"data_access_role_arn": aws.StringValue(apiObject.DataAccessRoleArn),
+		"data_location":        aws.StringValue(apiObject.DataLocation),
+	}
+}
+
+func flattenIngestedEventsDetail(apiObject *frauddetector.IngestedEventsDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IngestedEventsTimeWindow; v != nil {
+		tfMap["ingested_events_time_window"] = []interface{}{map[string]interface{}{
+			"end_time":   aws.StringValue(v.EndTime),
+			"start_time": aws.StringValue(v.StartTime),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,193 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorModelVersion_basic(t *testing.T) {
+	dataLocation := os.Getenv("FRAUDDETECTOR_MODEL_VERSION_DATA_LOCATION")
+
+	if dataLocation == "" {
+		t.Skip("Environment variable FRAUDDETECTOR_MODEL_VERSION_DATA_LOCATION is not set")
+	}
+
+	var v frauddetector.GetModelVersionOutput
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_model_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckModelVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelVersionConfig(rName, dataLocation, "INACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "external_events_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_events_detail.0.data_location", dataLocation),
+					resource.TestCheckResourceAttr(resourceName, "ingested_events_detail.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "model_id", "aws_frauddetector_model.test", "model_id"),
+					resource.TestCheckResourceAttr(resourceName, "model_version_number", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.0.label_schema.0.label_mapper.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.0.model_variables.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_source", frauddetector.TrainingDataSourceEnumExternalEvents),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelVersionConfig(rName, dataLocation, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckModelVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_model_version" {
+			continue
+		}
+
+		modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tffrauddetector.FindModelVersionByThreePartKey(conn, modelID, modelType, modelVersionNumber)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Model Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckModelVersionExists(n string, v *frauddetector.GetModelVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Model Version ID is set")
+		}
+
+		modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindModelVersionByThreePartKey(conn, modelID, modelType, modelVersionNumber)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelVersionConfig(rName, dataLocation, status string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfigUpdated(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_frauddetector_model" "test" {
+  model_id        = %[1]q
+  model_type      = "ONLINE_FRAUD_INSIGHTS"
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+
+resource "aws_iam_role" "test" {
+  name = "tf-acc-test-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "frauddetector.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonS3ReadOnlyAccess"
+}
+
+resource "aws_frauddetector_model_version" "test" {
+  model_id             = aws_frauddetector_model.test.model_id
+  model_type           = aws_frauddetector_model.test.model_type
+  status               = %[3]q
+  training_data_source = "EXTERNAL_EVENTS"
+
+  external_events_detail {
+    data_access_role_arn = aws_iam_role.test.arn
+    data_location        = %[2]q
+  }
+
+  training_data_schema {
+    model_variables = [aws_frauddetector_variable.test.name, aws_frauddetector_variable.test2.name]
+
+    label_schema {
+      label_mapper {
+        label  = aws_frauddetector_label.fraud.name
+        values = ["fraud"]
+      }
+
+      label_mapper {
+        label  = aws_frauddetector_label.legit.name
+        values = ["legit"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, dataLocation, status))
+}
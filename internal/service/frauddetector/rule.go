@@ -0,0 +1,287 @@
+package frauddetector
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRuleCreate,
+		Read:   resourceRuleRead,
+		Update: resourceRuleUpdate,
+		Delete: resourceRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      frauddetector.LanguageDetectorpl,
+				ValidateFunc: validation.StringInSlice(frauddetector.Language_Values(), false),
+			},
+			"outcomes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"rule_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	detectorID := d.Get("detector_id").(string)
+	ruleID := d.Get("rule_id").(string)
+	id := RuleCreateResourceID(detectorID, ruleID)
+	input := &frauddetector.CreateRuleInput{
+		DetectorId: aws.String(detectorID),
+		Expression: aws.String(d.Get("expression").(string)),
+		Language:   aws.String(d.Get("language").(string)),
+		Outcomes:   flex.ExpandStringList(d.Get("outcomes").([]interface{})),
+		RuleId:     aws.String(ruleID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Rule: %s", input)
+	_, err := conn.CreateRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRuleRead(d, meta)
+}
+
+func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	detectorID, ruleID, err := RuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindLatestRuleVersionByTwoPartKey(conn, detectorID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Rule (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("detector_id", output.DetectorId)
+	d.Set("expression", output.Expression)
+	d.Set("language", output.Language)
+	d.Set("outcomes", aws.StringValueSlice(output.Outcomes))
+	d.Set("rule_id", output.RuleId)
+	d.Set("rule_version", output.RuleVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Rule (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	rule := &frauddetector.Rule{
+		DetectorId:  aws.String(d.Get("detector_id").(string)),
+		RuleId:      aws.String(d.Get("rule_id").(string)),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	// Changes to the rule logic create a new rule version, which carries its own ARN and tags.
+	if d.HasChanges("expression", "language", "outcomes") {
+		input := &frauddetector.UpdateRuleVersionInput{
+			Expression: aws.String(d.Get("expression").(string)),
+			Language:   aws.String(d.Get("language").(string)),
+			Outcomes:   flex.ExpandStringList(d.Get("outcomes").([]interface{})),
+			Rule:       rule,
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if tags := tftags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+			input.Tags = Tags(tags.IgnoreAWS())
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Rule version: %s", input)
+		_, err := conn.UpdateRuleVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Rule (%s) version: %w", d.Id(), err)
+		}
+
+		return resourceRuleRead(d, meta)
+	}
+
+	if d.HasChange("description") {
+		input := &frauddetector.UpdateRuleMetadataInput{
+			Description: aws.String(d.Get("description").(string)),
+			Rule:        rule,
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Rule metadata: %s", input)
+		_, err := conn.UpdateRuleMetadata(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Rule (%s) metadata: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceRuleRead(d, meta)
+}
+
+func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FraudDetectorConn
+
+	detectorID, ruleID, err := RuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	versions, err := FindRuleVersionsByTwoPartKey(conn, detectorID, ruleID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Rule (%s) versions: %w", d.Id(), err)
+	}
+
+	for _, v := range versions {
+		ruleVersion := aws.StringValue(v.RuleVersion)
+
+		log.Printf("[DEBUG] Deleting Fraud Detector Rule (%s) version: %s", d.Id(), ruleVersion)
+		_, err := conn.DeleteRule(&frauddetector.DeleteRuleInput{
+			Rule: &frauddetector.Rule{
+				DetectorId:  aws.String(detectorID),
+				RuleId:      aws.String(ruleID),
+				RuleVersion: aws.String(ruleVersion),
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Fraud Detector Rule (%s) version (%s): %w", d.Id(), ruleVersion, err)
+		}
+	}
+
+	return nil
+}
+
+func ruleVersionNumber(v string) int {
+	n, err := strconv.Atoi(v)
+
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
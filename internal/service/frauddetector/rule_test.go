@@ -0,0 +1,161 @@
+package frauddetector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorRule_basic(t *testing.T) {
+	var v frauddetector.RuleDetail
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig(rName, "unknown"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("rule/%[1]s/%[1]s/1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_frauddetector_detector.test", "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "language", frauddetector.LanguageDetectorpl),
+					resource.TestCheckResourceAttr(resourceName, "outcomes.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "outcomes.0", "aws_frauddetector_outcome.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfig(rName, "blocked@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("rule/%[1]s/%[1]s/2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorRule_disappears(t *testing.T) {
+	var v frauddetector.RuleDetail
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(frauddetector.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig(rName, "unknown"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffrauddetector.ResourceRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_rule" {
+			continue
+		}
+
+		detectorID, ruleID, err := tffrauddetector.RuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tffrauddetector.FindRuleVersionsByTwoPartKey(conn, detectorID, ruleID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRuleExists(n string, v *frauddetector.RuleDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Rule ID is set")
+		}
+
+		detectorID, ruleID, err := tffrauddetector.RuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn
+
+		output, err := tffrauddetector.FindLatestRuleVersionByTwoPartKey(conn, detectorID, ruleID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRuleConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_outcome" "test" {
+  name = "%[1]s_review"
+}
+`, rName))
+}
+
+func testAccRuleConfig(rName, email string) string {
+	return acctest.ConfigCompose(testAccRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  expression  = format("$%%s == \"%[2]s\"", aws_frauddetector_variable.test.name)
+  outcomes    = [aws_frauddetector_outcome.test.name]
+}
+`, rName, email))
+}
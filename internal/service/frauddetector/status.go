@@ -0,0 +1,24 @@
+package frauddetector
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusModelVersion(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindModelVersionByThreePartKey(conn, modelID, modelType, modelVersionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
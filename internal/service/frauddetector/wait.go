@@ -0,0 +1,59 @@
+package frauddetector
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitModelVersionTrained(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.GetModelVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{modelVersionStatusTrainingInProgress},
+		Target:  []string{modelVersionStatusTrainingComplete},
+		Refresh: statusModelVersion(conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*frauddetector.GetModelVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelVersionActivated(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.GetModelVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{modelVersionStatusActivateInProgress, modelVersionStatusActivateRequested},
+		Target:  []string{modelVersionStatusActive},
+		Refresh: statusModelVersion(conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*frauddetector.GetModelVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelVersionDeactivated(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.GetModelVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{modelVersionStatusInactivateInProgress, modelVersionStatusInactivateRequested},
+		Target:  []string{modelVersionStatusInactive},
+		Refresh: statusModelVersion(conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*frauddetector.GetModelVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,177 @@
+package glacier
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	dataRetrievalStrategyBytesPerHour = "BytesPerHour"
+	dataRetrievalStrategyFreeTier     = "FreeTier"
+	dataRetrievalStrategyNone         = "None"
+)
+
+func dataRetrievalStrategy_Values() []string {
+	return []string{
+		dataRetrievalStrategyBytesPerHour,
+		dataRetrievalStrategyFreeTier,
+		dataRetrievalStrategyNone,
+	}
+}
+
+func ResourceDataRetrievalPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataRetrievalPolicyPut,
+		Read:   resourceDataRetrievalPolicyRead,
+		Update: resourceDataRetrievalPolicyPut,
+		Delete: resourceDataRetrievalPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_per_hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dataRetrievalStrategy_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataRetrievalPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	input := &glacier.SetDataRetrievalPolicyInput{
+		AccountId: aws.String("-"),
+		Policy: &glacier.DataRetrievalPolicy{
+			Rules: expandDataRetrievalRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Setting Glacier Data Retrieval Policy: %s", input)
+	_, err := conn.SetDataRetrievalPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting Glacier Data Retrieval Policy: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceDataRetrievalPolicyRead(d, meta)
+}
+
+func resourceDataRetrievalPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	output, err := conn.GetDataRetrievalPolicy(&glacier.GetDataRetrievalPolicyInput{
+		AccountId: aws.String("-"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Glacier Data Retrieval Policy (%s): %w", d.Id(), err)
+	}
+
+	var rules []*glacier.DataRetrievalRule
+
+	if output.Policy != nil {
+		rules = output.Policy.Rules
+	}
+
+	if err := d.Set("rule", flattenDataRetrievalRules(rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataRetrievalPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	// Removing the resource restores the account default of the free tier strategy.
+	input := &glacier.SetDataRetrievalPolicyInput{
+		AccountId: aws.String("-"),
+		Policy: &glacier.DataRetrievalPolicy{
+			Rules: []*glacier.DataRetrievalRule{
+				{
+					Strategy: aws.String(dataRetrievalStrategyFreeTier),
+				},
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Glacier Data Retrieval Policy: %s", d.Id())
+	_, err := conn.SetDataRetrievalPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error resetting Glacier Data Retrieval Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataRetrievalRules(tfList []interface{}) []*glacier.DataRetrievalRule {
+	var apiObjects []*glacier.DataRetrievalRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &glacier.DataRetrievalRule{}
+
+		if v, ok := tfMap["strategy"].(string); ok && v != "" {
+			apiObject.Strategy = aws.String(v)
+		}
+
+		// BytesPerHour may only be specified for the BytesPerHour strategy.
+		if v, ok := tfMap["bytes_per_hour"].(int); ok && v > 0 && aws.StringValue(apiObject.Strategy) == dataRetrievalStrategyBytesPerHour {
+			apiObject.BytesPerHour = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDataRetrievalRules(apiObjects []*glacier.DataRetrievalRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"bytes_per_hour": int(aws.Int64Value(apiObject.BytesPerHour)),
+			"strategy":       aws.StringValue(apiObject.Strategy),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,97 @@
+package glacier_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccGlacierDataRetrievalPolicy_basic(t *testing.T) {
+	resourceName := "aws_glacier_data_retrieval_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glacier.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataRetrievalPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataRetrievalPolicyConfig("None"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.strategy", "None"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.bytes_per_hour", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataRetrievalPolicyBytesPerHourConfig(1073741824),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.strategy", "BytesPerHour"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.bytes_per_hour", "1073741824"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataRetrievalPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glacier_data_retrieval_policy" {
+			continue
+		}
+
+		output, err := conn.GetDataRetrievalPolicy(&glacier.GetDataRetrievalPolicyInput{
+			AccountId: aws.String("-"),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Policy != nil {
+			for _, rule := range output.Policy.Rules {
+				if v := aws.StringValue(rule.Strategy); v != "FreeTier" {
+					return fmt.Errorf("Glacier Data Retrieval Policy (%s) not reset, strategy: %s", rs.Primary.ID, v)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccDataRetrievalPolicyConfig(strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_data_retrieval_policy" "test" {
+  rule {
+    strategy = %[1]q
+  }
+}
+`, strategy)
+}
+
+func testAccDataRetrievalPolicyBytesPerHourConfig(bytesPerHour int) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_data_retrieval_policy" "test" {
+  rule {
+    bytes_per_hour = %[1]d
+    strategy       = "BytesPerHour"
+  }
+}
+`, bytesPerHour)
+}
@@ -1,6 +1,7 @@
 package glacier
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,24 +21,44 @@ func ResourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVaultLockCreate,
 		Read:   resourceVaultLockRead,
-		// Allow ignore_deletion_error update
-		Update: schema.Noop,
+		Update: resourceVaultLockUpdate,
 		Delete: resourceVaultLockDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customdiff.ForceNewIf("complete_lock", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			if d.Id() == "" {
+				return false
+			}
+
+			o, n := d.GetChange("complete_lock")
+
+			// A completed lock is immutable and can only be replaced by locking a new vault.
+			if o.(bool) && !n.(bool) {
+				return true
+			}
+
+			// Completing an in-progress lock in place requires its lock ID, which is unknown
+			// for locks imported or initiated by an earlier version of the provider.
+			return !o.(bool) && n.(bool) && d.Get("lock_id").(string) == ""
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"lock_id": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -44,6 +66,10 @@ func ResourceVaultLock() *schema.Resource {
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vault_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -73,23 +99,13 @@ func resourceVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(vaultName)
+	// The lock ID is only returned when the lock is initiated and is required to complete it later.
+	d.Set("lock_id", output.LockId)
 
-	if !d.Get("complete_lock").(bool) {
-		return resourceVaultLockRead(d, meta)
-	}
-
-	completeLockInput := &glacier.CompleteVaultLockInput{
-		LockId:    output.LockId,
-		VaultName: aws.String(vaultName),
-	}
-
-	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, completeLockInput)
-	if _, err := conn.CompleteVaultLock(completeLockInput); err != nil {
-		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %s", vaultName, err)
-	}
-
-	if err := waitForGlacierVaultLockCompletion(conn, vaultName); err != nil {
-		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
+	if d.Get("complete_lock").(bool) {
+		if err := completeGlacierVaultLock(conn, vaultName, aws.StringValue(output.LockId)); err != nil {
+			return err
+		}
 	}
 
 	return resourceVaultLockRead(d, meta)
@@ -124,11 +140,30 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("complete_lock", aws.StringValue(output.State) == "Locked")
 	d.Set("policy", output.Policy)
+	d.Set("state", output.State)
 	d.Set("vault_name", d.Id())
 
 	return nil
 }
 
+func resourceVaultLockUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) {
+		lockID := d.Get("lock_id").(string)
+
+		if lockID == "" {
+			return fmt.Errorf("error completing Glacier Vault (%s) Lock: lock ID unknown, the in-progress lock must be aborted and initiated again", d.Id())
+		}
+
+		if err := completeGlacierVaultLock(conn, d.Id(), lockID); err != nil {
+			return err
+		}
+	}
+
+	return resourceVaultLockRead(d, meta)
+}
+
 func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlacierConn
 
@@ -150,6 +185,24 @@ func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func completeGlacierVaultLock(conn *glacier.Glacier, vaultName, lockID string) error {
+	input := &glacier.CompleteVaultLockInput{
+		LockId:    aws.String(lockID),
+		VaultName: aws.String(vaultName),
+	}
+
+	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, input)
+	if _, err := conn.CompleteVaultLock(input); err != nil {
+		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %s", vaultName, err)
+	}
+
+	if err := waitForGlacierVaultLockCompletion(conn, vaultName); err != nil {
+		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", vaultName, err)
+	}
+
+	return nil
+}
+
 func glacierVaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &glacier.GetVaultLockInput{
@@ -66,8 +66,9 @@ func DataSourcePolicyDocument() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"test": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validPolicyConditionOperator,
 									},
 									"values": {
 										Type:     schema.TypeList,
@@ -201,6 +201,20 @@ func TestAccIAMPolicyDocumentDataSource_noStatementOverride(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_invalidConditionOperator(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentInvalidConditionOperatorConfig,
+				ExpectError: regexp.MustCompile(`is not a valid IAM policy condition operator`),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -1063,6 +1077,21 @@ data "aws_iam_policy_document" "test" {
 }
 `
 
+var testAccPolicyDocumentInvalidConditionOperatorConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "aws:PrincipalTag/team"
+      values   = ["example"]
+    }
+  }
+}
+`
+
 var testAccPolicyDocumentDuplicateBlankSidConfig = `
 data "aws_iam_policy_document" "test" {
   statement {
@@ -0,0 +1,121 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePoliciesExclusivePut,
+		Read:   resourceRolePoliciesExclusiveRead,
+		Update: resourceRolePoliciesExclusivePut,
+		Delete: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	want := d.Get("policy_names").(*schema.Set)
+
+	have, err := readIamRolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	var remove []*string
+	existing := make(map[string]bool, len(have))
+
+	for _, name := range have {
+		existing[aws.StringValue(name)] = true
+
+		if !want.Contains(aws.StringValue(name)) {
+			remove = append(remove, name)
+		}
+	}
+
+	// Inline policies are only identified by name here, so missing ones cannot be created.
+	var missing []string
+
+	for _, v := range want.List() {
+		if name := v.(string); !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("IAM Role (%s) inline policies not found: %s; create them with the aws_iam_role_policy resource", roleName, strings.Join(missing, ", "))
+	}
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policies not managed by Terraform: %s", roleName, aws.StringValueSlice(remove))
+		if err := deleteIamRolePolicies(conn, roleName, remove); err != nil {
+			return fmt.Errorf("error deleting IAM Role (%s) inline policies: %w", roleName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	_, err := FindRoleByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing exclusive inline policies from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", d.Id(), err)
+	}
+
+	policyNames, err := readIamRolePolicyNames(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", d.Id(), err)
+	}
+
+	d.Set("policy_names", aws.StringValueSlice(policyNames))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing this resource only stops Terraform from exclusively managing
+	// the role's inline policies; the policies themselves are left in place.
+	log.Printf("[WARN] IAM Role (%s) exclusive inline policies removed from state; existing policies were not deleted", d.Id())
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePolicyAttachmentsExclusivePut,
+		Read:   resourceRolePolicyAttachmentsExclusiveRead,
+		Update: resourceRolePolicyAttachmentsExclusivePut,
+		Delete: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	want := d.Get("policy_arns").(*schema.Set)
+
+	have, err := readIamRolePolicyAttachments(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) managed policy attachments: %w", roleName, err)
+	}
+
+	var remove []*string
+	attached := make(map[string]bool, len(have))
+
+	for _, arn := range have {
+		attached[aws.StringValue(arn)] = true
+
+		if !want.Contains(aws.StringValue(arn)) {
+			remove = append(remove, arn)
+		}
+	}
+
+	for _, v := range want.List() {
+		arn := v.(string)
+
+		if attached[arn] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Attaching IAM Role (%s) managed policy: %s", roleName, arn)
+		if err := attachPolicyToRole(conn, roleName, arn); err != nil {
+			return fmt.Errorf("error attaching IAM Role (%s) managed policy (%s): %w", roleName, arn, err)
+		}
+	}
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Detaching IAM Role (%s) managed policy attachments not managed by Terraform: %s", roleName, aws.StringValueSlice(remove))
+		if err := deleteIamRolePolicyAttachments(conn, roleName, remove); err != nil {
+			return fmt.Errorf("error detaching IAM Role (%s) managed policies: %w", roleName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	_, err := FindRoleByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing exclusive managed policy attachments from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", d.Id(), err)
+	}
+
+	policyARNs, err := readIamRolePolicyAttachments(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) managed policy attachments: %w", d.Id(), err)
+	}
+
+	d.Set("policy_arns", aws.StringValueSlice(policyARNs))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing this resource only stops Terraform from exclusively managing
+	// the role's managed policy attachments; the attachments themselves are
+	// left in place.
+	log.Printf("[WARN] IAM Role (%s) exclusive managed policy attachments removed from state; existing attachments were not removed", d.Id())
+
+	return nil
+}
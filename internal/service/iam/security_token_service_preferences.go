@@ -0,0 +1,82 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceSecurityTokenServicePreferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityTokenServicePreferencesPut,
+		Read:   resourceSecurityTokenServicePreferencesRead,
+		Update: resourceSecurityTokenServicePreferencesPut,
+		Delete: resourceSecurityTokenServicePreferencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_endpoint_token_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(iam.GlobalEndpointTokenVersion_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceSecurityTokenServicePreferencesPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	input := &iam.SetSecurityTokenServicePreferencesInput{
+		GlobalEndpointTokenVersion: aws.String(d.Get("global_endpoint_token_version").(string)),
+	}
+
+	log.Printf("[DEBUG] Setting IAM Security Token Service Preferences: %s", input)
+	_, err := conn.SetSecurityTokenServicePreferences(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting IAM Security Token Service Preferences: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceSecurityTokenServicePreferencesRead(d, meta)
+}
+
+func resourceSecurityTokenServicePreferencesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	output, err := conn.GetAccountSummary(&iam.GetAccountSummaryInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Security Token Service Preferences (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading IAM Security Token Service Preferences (%s): empty response", d.Id())
+	}
+
+	if v, ok := output.SummaryMap[iam.SummaryKeyTypeGlobalEndpointTokenVersion]; ok {
+		d.Set("global_endpoint_token_version", fmt.Sprintf("v%dToken", aws.Int64Value(v)))
+	} else {
+		d.Set("global_endpoint_token_version", nil)
+	}
+
+	return nil
+}
+
+func resourceSecurityTokenServicePreferencesDelete(d *schema.ResourceData, meta interface{}) error {
+	// The global endpoint token version cannot be unset, so deleting this
+	// resource only removes it from state.
+	log.Printf("[WARN] IAM Security Token Service Preferences (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
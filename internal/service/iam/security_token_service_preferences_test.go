@@ -0,0 +1,51 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMSecurityTokenServicePreferences_basic(t *testing.T) {
+	resourceName := "aws_iam_security_token_service_preferences.test"
+
+	// Account-wide setting, so the test cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		// The setting cannot be removed.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityTokenServicePreferencesConfig(iam.GlobalEndpointTokenVersionV2token),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "global_endpoint_token_version", iam.GlobalEndpointTokenVersionV2token),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityTokenServicePreferencesConfig(iam.GlobalEndpointTokenVersionV1token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "global_endpoint_token_version", iam.GlobalEndpointTokenVersionV1token),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityTokenServicePreferencesConfig(version string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_security_token_service_preferences" "test" {
+  global_endpoint_token_version = %[1]q
+}
+`, version)
+}
@@ -11,10 +11,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceServiceLinkedRole() *schema.Resource {
@@ -115,23 +115,18 @@ func resourceServiceLinkedRoleRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	params := &iam.GetRoleInput{
-		RoleName: aws.String(roleName),
-	}
+	role, err := FindRoleByName(conn, roleName)
 
-	resp, err := conn.GetRole(params)
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM service linked role %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
 	if err != nil {
-		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-			log.Printf("[WARN] IAM service linked role %s not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return err
+		return fmt.Errorf("error reading IAM service linked role (%s): %w", d.Id(), err)
 	}
 
-	role := resp.Role
-
 	d.Set("arn", role.Arn)
 	d.Set("aws_service_name", serviceName)
 	d.Set("create_date", aws.TimeValue(role.CreateDate).Format(time.RFC3339))
@@ -204,7 +199,8 @@ func DecodeServiceLinkedRoleID(id string) (serviceName, roleName, customSuffix s
 	serviceName = resourceParts[2]
 	roleName = resourceParts[3]
 
-	roleNameParts := strings.Split(roleName, "_")
+	// Custom suffixes may themselves contain underscores.
+	roleNameParts := strings.SplitN(roleName, "_", 2)
 	if len(roleNameParts) == 2 {
 		customSuffix = roleNameParts[1]
 	}
@@ -230,36 +226,11 @@ func DeleteServiceLinkedRole(conn *iam.IAM, roleName string) (string, error) {
 }
 
 func DeleteServiceLinkedRoleWaiter(conn *iam.IAM, deletionTaskID string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{iam.DeletionTaskStatusTypeInProgress, iam.DeletionTaskStatusTypeNotStarted},
-		Target:  []string{iam.DeletionTaskStatusTypeSucceeded},
-		Refresh: deleteIamServiceLinkedRoleRefreshFunc(conn, deletionTaskID),
-		Timeout: 5 * time.Minute,
-		Delay:   10 * time.Second,
-	}
+	_, err := waitServiceLinkedRoleDeleted(conn, deletionTaskID)
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-			return nil
-		}
-		return err
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
 	}
 
-	return nil
-}
-
-func deleteIamServiceLinkedRoleRefreshFunc(conn *iam.IAM, deletionTaskId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		params := &iam.GetServiceLinkedRoleDeletionStatusInput{
-			DeletionTaskId: aws.String(deletionTaskId),
-		}
-
-		resp, err := conn.GetServiceLinkedRoleDeletionStatus(params)
-		if err != nil {
-			return nil, "", err
-		}
-
-		return resp, aws.StringValue(resp.Status), nil
-	}
+	return err
 }
@@ -45,6 +45,13 @@ func TestDecodeIamServiceLinkedRoleID(t *testing.T) {
 			CustomSuffix: "custom-suffix",
 			ErrCount:     0,
 		},
+		{
+			Input:        "arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling_custom_suffix", //lintignore:AWSAT005
+			ServiceName:  "autoscaling.amazonaws.com",
+			RoleName:     "AWSServiceRoleForAutoScaling_custom_suffix",
+			CustomSuffix: "custom_suffix",
+			ErrCount:     0,
+		},
 		{
 			Input:        "arn:aws:iam::123456789012:role/aws-service-role/dynamodb.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_DynamoDBTable", //lintignore:AWSAT005
 			ServiceName:  "dynamodb.application-autoscaling.amazonaws.com",
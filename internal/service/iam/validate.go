@@ -65,3 +65,67 @@ func validOpenIDURL(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// policyConditionOperators are the base IAM JSON policy condition operators.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var policyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+func validPolicyConditionOperator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	operator := value
+
+	// Set operators for multivalued context keys.
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if len(operator) > len(prefix) && strings.EqualFold(operator[:len(prefix)], prefix) {
+			operator = operator[len(prefix):]
+			break
+		}
+	}
+
+	const suffix = "IfExists"
+	ifExists := false
+	if len(operator) > len(suffix) && strings.EqualFold(operator[len(operator)-len(suffix):], suffix) {
+		operator = operator[:len(operator)-len(suffix)]
+		ifExists = true
+	}
+
+	for _, o := range policyConditionOperators {
+		if strings.EqualFold(operator, o) {
+			if ifExists && o == "Null" {
+				errors = append(errors, fmt.Errorf("%q (%s): the Null condition operator cannot be used with IfExists", k, value))
+			}
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) is not a valid IAM policy condition operator", k, value))
+	return
+}
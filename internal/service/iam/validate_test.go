@@ -114,3 +114,39 @@ func TestValidOpenIDURL(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPolicyConditionOperator(t *testing.T) {
+	validOperators := []string{
+		"StringEquals",
+		"stringlike",
+		"ArnLike",
+		"Bool",
+		"Null",
+		"NumericLessThanEquals",
+		"StringEqualsIfExists",
+		"ForAllValues:StringEquals",
+		"ForAnyValue:StringLikeIfExists",
+	}
+	for _, s := range validOperators {
+		_, errors := validPolicyConditionOperator(s, "test")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid condition operator: %v", s, errors)
+		}
+	}
+
+	invalidOperators := []string{
+		"",
+		"StringEqual",
+		"IfExists",
+		"NullIfExists",
+		"ForAllValues:",
+		"ForEachValue:StringEquals",
+		"StringEquals:ForAllValues",
+	}
+	for _, s := range invalidOperators {
+		_, errors := validPolicyConditionOperator(s, "test")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid condition operator", s)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package iam
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -18,6 +20,8 @@ const (
 	// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/troubleshoot_general.html#troubleshoot_general_eventual-consistency
 	PropagationTimeout = 2 * time.Minute
 
+	ServiceLinkedRoleDeletedTimeout = 5 * time.Minute
+
 	RoleStatusARNIsUniqueID = "uniqueid"
 	RoleStatusARNIsARN      = "arn"
 	RoleStatusNotFound      = "notfound"
@@ -63,3 +67,57 @@ func statusRoleCreate(conn *iam.IAM, id string, role *iam.Role) resource.StateRe
 		return output, RoleStatusARNIsUniqueID, nil
 	}
 }
+
+func waitServiceLinkedRoleDeleted(conn *iam.IAM, deletionTaskID string) (*iam.GetServiceLinkedRoleDeletionStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iam.DeletionTaskStatusTypeInProgress, iam.DeletionTaskStatusTypeNotStarted},
+		Target:  []string{iam.DeletionTaskStatusTypeSucceeded},
+		Refresh: statusServiceLinkedRoleDeletion(conn, deletionTaskID),
+		Timeout: ServiceLinkedRoleDeletedTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iam.GetServiceLinkedRoleDeletionStatusOutput); ok {
+		if status, reason := aws.StringValue(output.Status), output.Reason; status == iam.DeletionTaskStatusTypeFailed && reason != nil {
+			tfresource.SetLastError(err, deletionTaskFailureReasonError(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusServiceLinkedRoleDeletion(conn *iam.IAM, deletionTaskID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetServiceLinkedRoleDeletionStatus(&iam.GetServiceLinkedRoleDeletionStatusInput{
+			DeletionTaskId: aws.String(deletionTaskID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func deletionTaskFailureReasonError(apiObject *iam.DeletionTaskFailureReasonType) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	msg := aws.StringValue(apiObject.Reason)
+
+	for _, usage := range apiObject.RoleUsageList {
+		if usage == nil {
+			continue
+		}
+
+		msg = fmt.Sprintf("%s; role in use in %s by: %s", msg, aws.StringValue(usage.Region), strings.Join(aws.StringValueSlice(usage.Resources), ", "))
+	}
+
+	return errors.New(msg)
+}
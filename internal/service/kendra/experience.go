@@ -0,0 +1,462 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExperience() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExperienceCreate,
+		Read:   resourceExperienceRead,
+		Update: resourceExperienceUpdate,
+		Delete: resourceExperienceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_source_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							AtLeastOneOf: []string{"configuration.0.content_source_configuration", "configuration.0.user_identity_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 100),
+										},
+									},
+									"direct_put_content": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"faq_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 100),
+										},
+									},
+								},
+							},
+						},
+						"user_identity_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							AtLeastOneOf: []string{"configuration.0.content_source_configuration", "configuration.0.user_identity_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"identity_attribute_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 1000),
+											validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "must begin with a letter or number and contain only alphanumeric characters, underscores and hyphens"),
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"endpoints": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"experience_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1000),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "must begin with a letter or number and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExperienceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	name := d.Get("name").(string)
+	indexID := d.Get("index_id").(string)
+	input := &kendra.CreateExperienceInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		IndexId:     aws.String(indexID),
+		Name:        aws.String(name),
+		RoleArn:     aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandExperienceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Experience: %s", input)
+	outputRaw, err := tfresource.RetryWhen(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateExperience(input)
+		},
+		isRoleNotPropagatedError,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Experience (%s): %w", name, err)
+	}
+
+	experienceID := aws.StringValue(outputRaw.(*kendra.CreateExperienceOutput).Id)
+	d.SetId(ExperienceCreateResourceID(experienceID, indexID))
+
+	if _, err := waitExperienceCreated(conn, experienceID, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Experience (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceExperienceRead(d, meta)
+}
+
+func resourceExperienceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	experienceID, indexID, err := ExperienceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindExperienceByTwoPartKey(conn, experienceID, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Experience (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Experience (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/experience/%s", indexID, experienceID),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("experience_id", output.Id)
+	d.Set("index_id", output.IndexId)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+
+	if err := d.Set("configuration", flattenExperienceConfiguration(output.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
+	if err := d.Set("endpoints", flattenExperienceEndpoints(output.Endpoints)); err != nil {
+		return fmt.Errorf("error setting endpoints: %w", err)
+	}
+
+	return nil
+}
+
+func resourceExperienceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	experienceID, indexID, err := ExperienceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &kendra.UpdateExperienceInput{
+		Id:      aws.String(experienceID),
+		IndexId: aws.String(indexID),
+	}
+
+	if d.HasChange("configuration") {
+		if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Configuration = expandExperienceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Kendra Experience: %s", input)
+	_, err = tfresource.RetryWhen(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateExperience(input)
+		},
+		isRoleNotPropagatedError,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error updating Kendra Experience (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitExperienceUpdated(conn, experienceID, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Experience (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceExperienceRead(d, meta)
+}
+
+func resourceExperienceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	experienceID, indexID, err := ExperienceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Experience: %s", d.Id())
+	_, err = conn.DeleteExperience(&kendra.DeleteExperienceInput{
+		Id:      aws.String(experienceID),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Experience (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitExperienceDeleted(conn, experienceID, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Experience (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// isRoleNotPropagatedError retries the errors Kendra returns while it is not
+// yet able to assume a newly created IAM role.
+func isRoleNotPropagatedError(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists") {
+		return true, err
+	}
+
+	return false, err
+}
+
+func expandExperienceConfiguration(tfMap map[string]interface{}) *kendra.ExperienceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.ExperienceConfiguration{}
+
+	if v, ok := tfMap["content_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContentSourceConfiguration = expandContentSourceConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["user_identity_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UserIdentityConfiguration = expandUserIdentityConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandContentSourceConfiguration(tfMap map[string]interface{}) *kendra.ContentSourceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.ContentSourceConfiguration{}
+
+	if v, ok := tfMap["data_source_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DataSourceIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["direct_put_content"].(bool); ok {
+		apiObject.DirectPutContent = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["faq_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FaqIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandUserIdentityConfiguration(tfMap map[string]interface{}) *kendra.UserIdentityConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.UserIdentityConfiguration{}
+
+	if v, ok := tfMap["identity_attribute_name"].(string); ok && v != "" {
+		apiObject.IdentityAttributeName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenExperienceConfiguration(apiObject *kendra.ExperienceConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContentSourceConfiguration; v != nil {
+		tfMap["content_source_configuration"] = flattenContentSourceConfiguration(v)
+	}
+
+	if v := apiObject.UserIdentityConfiguration; v != nil {
+		tfMap["user_identity_configuration"] = flattenUserIdentityConfiguration(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContentSourceConfiguration(apiObject *kendra.ContentSourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"direct_put_content": aws.BoolValue(apiObject.DirectPutContent),
+	}
+
+	if v := apiObject.DataSourceIds; v != nil {
+		tfMap["data_source_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.FaqIds; v != nil {
+		tfMap["faq_ids"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserIdentityConfiguration(apiObject *kendra.UserIdentityConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"identity_attribute_name": aws.StringValue(apiObject.IdentityAttributeName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExperienceEndpoints(apiObjects []*kendra.ExperienceEndpoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"endpoint":      aws.StringValue(apiObject.Endpoint),
+			"endpoint_type": aws.StringValue(apiObject.EndpointType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,263 @@
+package kendra_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraExperience_basic(t *testing.T) {
+	var experience kendra.DescribeExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_experience.test"
+	indexID := testAccIndexID(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceConfig(rName, rName, "description1", indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName, &experience),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(fmt.Sprintf(`index/%s/experience/.+$`, indexID))),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrSet(resourceName, "experience_id"),
+					resource.TestCheckResourceAttr(resourceName, "index_id", indexID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.ExperienceStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperienceConfig(rName, rName2, "description2", indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName, &experience),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraExperience_configuration(t *testing.T) {
+	var experience kendra.DescribeExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_experience.test"
+	indexID := testAccIndexID(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceConfigurationConfig(rName, indexID, true, "12345ec2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName, &experience),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.content_source_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.content_source_configuration.0.direct_put_content", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.user_identity_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.user_identity_configuration.0.identity_attribute_name", "12345ec2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperienceConfigurationConfig(rName, indexID, false, "54321ec2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName, &experience),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.content_source_configuration.0.direct_put_content", "false"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.user_identity_configuration.0.identity_attribute_name", "54321ec2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraExperience_disappears(t *testing.T) {
+	var experience kendra.DescribeExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_experience.test"
+	indexID := testAccIndexID(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceConfig(rName, rName, "disappears", indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName, &experience),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceExperience(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckExperienceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_experience" {
+			continue
+		}
+
+		experienceID, indexID, err := tfkendra.ExperienceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindExperienceByTwoPartKey(conn, experienceID, indexID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Experience %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckExperienceExists(n string, v *kendra.DescribeExperienceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Experience ID is set")
+		}
+
+		experienceID, indexID, err := tfkendra.ExperienceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		output, err := tfkendra.FindExperienceByTwoPartKey(conn, experienceID, indexID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccExperienceBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "kendra.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "kendra:GetQuerySuggestions",
+        "kendra:Query",
+        "kendra:DescribeIndex",
+        "kendra:ListFaqs",
+        "kendra:DescribeDataSource",
+        "kendra:ListDataSources",
+        "kendra:DescribeFaq",
+        "sso:ListDirectoryAssociations",
+        "sso-directory:DescribeUsers",
+        "sso-directory:DescribeGroups",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccExperienceConfig(rName, name, description, indexID string) string {
+	return acctest.ConfigCompose(testAccExperienceBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_experience" "test" {
+  index_id    = %[3]q
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, name, description, indexID))
+}
+
+func testAccExperienceConfigurationConfig(rName, indexID string, directPutContent bool, identityAttributeName string) string {
+	return acctest.ConfigCompose(testAccExperienceBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_experience" "test" {
+  index_id = %[2]q
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  configuration {
+    content_source_configuration {
+      direct_put_content = %[3]t
+    }
+
+    user_identity_configuration {
+      identity_attribute_name = %[4]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, indexID, directPutContent, identityAttributeName))
+}
@@ -0,0 +1,61 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindExperienceByTwoPartKey(conn *kendra.Kendra, experienceID, indexID string) (*kendra.DescribeExperienceOutput, error) {
+	input := &kendra.DescribeExperienceInput{
+		Id:      aws.String(experienceID),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeExperience(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindQuerySuggestionsBlockListByTwoPartKey(conn *kendra.Kendra, blockListID, indexID string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	input := &kendra.DescribeQuerySuggestionsBlockListInput{
+		Id:      aws.String(blockListID),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeQuerySuggestionsBlockList(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
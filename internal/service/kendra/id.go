@@ -0,0 +1,44 @@
+package kendra
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = "/"
+
+// ExperienceCreateResourceID and QuerySuggestionsBlockListCreateResourceID
+// build the composite IDs used for Kendra index sub-resources, which are only
+// unique within their index.
+func ExperienceCreateResourceID(experienceID, indexID string) string {
+	return createResourceID(experienceID, indexID)
+}
+
+func ExperienceParseResourceID(id string) (string, string, error) {
+	return parseResourceID(id, "EXPERIENCEID")
+}
+
+func QuerySuggestionsBlockListCreateResourceID(blockListID, indexID string) string {
+	return createResourceID(blockListID, indexID)
+}
+
+func QuerySuggestionsBlockListParseResourceID(id string) (string, string, error) {
+	return parseResourceID(id, "QUERYSUGGESTIONSBLOCKLISTID")
+}
+
+func createResourceID(resourceID, indexID string) string {
+	parts := []string{resourceID, indexID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func parseResourceID(id, resourceIDName string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected %[2]s%[3]sINDEXID", id, resourceIDName, resourceIDSeparator)
+}
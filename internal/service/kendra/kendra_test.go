@@ -0,0 +1,19 @@
+package kendra_test
+
+import (
+	"os"
+	"testing"
+)
+
+// testAccIndexID returns the ID of an existing Kendra index. Creating an index
+// takes a long time and incurs significant charges, so the acceptance tests
+// for index sub-resources run against a pre-provisioned index.
+func testAccIndexID(t *testing.T) string {
+	v := os.Getenv("AWS_KENDRA_INDEX_ID")
+
+	if v == "" {
+		t.Skip("Environment variable AWS_KENDRA_INDEX_ID is not set")
+	}
+
+	return v
+}
@@ -0,0 +1,331 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQuerySuggestionsBlockList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQuerySuggestionsBlockListCreate,
+		Read:   resourceQuerySuggestionsBlockListRead,
+		Update: resourceQuerySuggestionsBlockListUpdate,
+		Delete: resourceQuerySuggestionsBlockListDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "must begin with a letter or number and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"query_suggestions_block_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_s3_path": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceQuerySuggestionsBlockListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	indexID := d.Get("index_id").(string)
+	input := &kendra.CreateQuerySuggestionsBlockListInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		IndexId:      aws.String(indexID),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		SourceS3Path: expandS3Path(d.Get("source_s3_path").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Query Suggestions Block List: %s", input)
+	outputRaw, err := tfresource.RetryWhen(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateQuerySuggestionsBlockList(input)
+		},
+		isRoleNotPropagatedError,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Query Suggestions Block List (%s): %w", name, err)
+	}
+
+	blockListID := aws.StringValue(outputRaw.(*kendra.CreateQuerySuggestionsBlockListOutput).Id)
+	d.SetId(QuerySuggestionsBlockListCreateResourceID(blockListID, indexID))
+
+	if _, err := waitQuerySuggestionsBlockListCreated(conn, blockListID, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Query Suggestions Block List (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceQuerySuggestionsBlockListRead(d, meta)
+}
+
+func resourceQuerySuggestionsBlockListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	blockListID, indexID, err := QuerySuggestionsBlockListParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindQuerySuggestionsBlockListByTwoPartKey(conn, blockListID, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Query Suggestions Block List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/query-suggestions-block-list/%s", indexID, blockListID),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("index_id", output.IndexId)
+	d.Set("name", output.Name)
+	d.Set("query_suggestions_block_list_id", output.Id)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+
+	if err := d.Set("source_s3_path", flattenS3Path(output.SourceS3Path)); err != nil {
+		return fmt.Errorf("error setting source_s3_path: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceQuerySuggestionsBlockListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		blockListID, indexID, err := QuerySuggestionsBlockListParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &kendra.UpdateQuerySuggestionsBlockListInput{
+			Id:      aws.String(blockListID),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source_s3_path") {
+			input.SourceS3Path = expandS3Path(d.Get("source_s3_path").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Query Suggestions Block List: %s", input)
+		_, err = tfresource.RetryWhen(tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateQuerySuggestionsBlockList(input)
+			},
+			isRoleNotPropagatedError,
+		)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitQuerySuggestionsBlockListUpdated(conn, blockListID, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kendra Query Suggestions Block List (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra Query Suggestions Block List (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceQuerySuggestionsBlockListRead(d, meta)
+}
+
+func resourceQuerySuggestionsBlockListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	blockListID, indexID, err := QuerySuggestionsBlockListParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Query Suggestions Block List: %s", d.Id())
+	_, err = conn.DeleteQuerySuggestionsBlockList(&kendra.DeleteQuerySuggestionsBlockListInput{
+		Id:      aws.String(blockListID),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitQuerySuggestionsBlockListDeleted(conn, blockListID, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Query Suggestions Block List (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3Path(tfList []interface{}) *kendra.S3Path {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &kendra.S3Path{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Path(apiObject *kendra.S3Path) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket": aws.StringValue(apiObject.Bucket),
+		"key":    aws.StringValue(apiObject.Key),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,306 @@
+package kendra_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraQuerySuggestionsBlockList_basic(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+	indexID := testAccIndexID(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rName, "description1", "test1.txt", indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(fmt.Sprintf(`index/%s/query-suggestions-block-list/.+$`, indexID))),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "index_id", indexID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "query_suggestions_block_list_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test1.txt"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.QuerySuggestionsBlockListStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rName2, "description2", "test2.txt", indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test2.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_tags(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+	indexID := testAccIndexID(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfigTags1(rName, indexID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfigTags2(rName, indexID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfigTags1(rName, indexID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_disappears(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+	indexID := testAccIndexID(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rName, "disappears", "test1.txt", indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceQuerySuggestionsBlockList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuerySuggestionsBlockListDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_query_suggestions_block_list" {
+			continue
+		}
+
+		blockListID, indexID, err := tfkendra.QuerySuggestionsBlockListParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindQuerySuggestionsBlockListByTwoPartKey(conn, blockListID, indexID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Query Suggestions Block List %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckQuerySuggestionsBlockListExists(n string, v *kendra.DescribeQuerySuggestionsBlockListOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Query Suggestions Block List ID is set")
+		}
+
+		blockListID, indexID, err := tfkendra.QuerySuggestionsBlockListParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		output, err := tfkendra.FindQuerySuggestionsBlockListByTwoPartKey(conn, blockListID, indexID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccQuerySuggestionsBlockListBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test1" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test1.txt"
+  content = "blocked"
+}
+
+resource "aws_s3_bucket_object" "test2" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test2.txt"
+  content = "blocked\nphrases"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "kendra.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject"]
+      Effect   = "Allow"
+      Resource = ["${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccQuerySuggestionsBlockListConfig(rName, name, description, key, indexID string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id    = %[4]q
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_bucket_object.test1, aws_s3_bucket_object.test2]
+}
+`, name, description, key, indexID))
+}
+
+func testAccQuerySuggestionsBlockListConfigTags1(rName, indexID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = %[2]q
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test1.key
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, indexID, tagKey1, tagValue1))
+}
+
+func testAccQuerySuggestionsBlockListConfigTags2(rName, indexID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = %[2]q
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test1.key
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, indexID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
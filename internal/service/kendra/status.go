@@ -0,0 +1,40 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusExperience(conn *kendra.Kendra, experienceID, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindExperienceByTwoPartKey(conn, experienceID, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusQuerySuggestionsBlockList(conn *kendra.Kendra, blockListID, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindQuerySuggestionsBlockListByTwoPartKey(conn, blockListID, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,125 @@
+package kendra
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitExperienceCreated(conn *kendra.Kendra, experienceID, indexID string, timeout time.Duration) (*kendra.DescribeExperienceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ExperienceStatusCreating},
+		Target:  []string{kendra.ExperienceStatusActive},
+		Refresh: statusExperience(conn, experienceID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperienceUpdated(conn *kendra.Kendra, experienceID, indexID string, timeout time.Duration) (*kendra.DescribeExperienceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ExperienceStatusCreating},
+		Target:  []string{kendra.ExperienceStatusActive},
+		Refresh: statusExperience(conn, experienceID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperienceDeleted(conn *kendra.Kendra, experienceID, indexID string, timeout time.Duration) (*kendra.DescribeExperienceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ExperienceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusExperience(conn, experienceID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListCreated(conn *kendra.Kendra, blockListID, indexID string, timeout time.Duration) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusCreating},
+		Target:  []string{kendra.QuerySuggestionsBlockListStatusActive},
+		Refresh: statusQuerySuggestionsBlockList(conn, blockListID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListUpdated(conn *kendra.Kendra, blockListID, indexID string, timeout time.Duration) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusUpdating},
+		Target:  []string{kendra.QuerySuggestionsBlockListStatusActive},
+		Refresh: statusQuerySuggestionsBlockList(conn, blockListID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListDeleted(conn *kendra.Kendra, blockListID, indexID string, timeout time.Duration) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusDeleting},
+		Target:  []string{},
+		Refresh: statusQuerySuggestionsBlockList(conn, blockListID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
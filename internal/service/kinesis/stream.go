@@ -1,6 +1,7 @@
 package kinesis
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,7 +34,23 @@ func ResourceStream() *schema.Resource {
 			State: resourceStreamImport,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				switch streamMode, shardCount := getStreamMode(diff), diff.Get("shard_count").(int); streamMode {
+				case kinesis.StreamModeOnDemand:
+					if shardCount > 0 {
+						return fmt.Errorf("shard_count must not be set when stream_mode is %s", streamMode)
+					}
+				case kinesis.StreamModeProvisioned:
+					if shardCount < 1 {
+						return fmt.Errorf("shard_count must be at least 1 when stream_mode is %s", streamMode)
+					}
+				}
+
+				return nil
+			},
+		),
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -58,7 +76,23 @@ func ResourceStream() *schema.Resource {
 
 			"shard_count": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+			},
+
+			"stream_mode_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stream_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kinesis.StreamMode_Values(), false),
+						},
+					},
+				},
 			},
 
 			"retention_period": {
@@ -120,10 +154,17 @@ func resourceStreamCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).KinesisConn
 	sn := d.Get("name").(string)
 	createOpts := &kinesis.CreateStreamInput{
-		ShardCount: aws.Int64(int64(d.Get("shard_count").(int))),
 		StreamName: aws.String(sn),
 	}
 
+	if streamMode := getStreamMode(d); streamMode == kinesis.StreamModeProvisioned {
+		createOpts.ShardCount = aws.Int64(int64(d.Get("shard_count").(int)))
+	} else {
+		createOpts.StreamModeDetails = &kinesis.StreamModeDetails{
+			StreamMode: aws.String(streamMode),
+		}
+	}
+
 	_, err := conn.CreateStream(createOpts)
 	if err != nil {
 		return fmt.Errorf("Unable to create stream: %s", err)
@@ -149,7 +190,9 @@ func resourceStreamCreate(d *schema.ResourceData, meta interface{}) error {
 	s := streamRaw.(*kinesisStreamState)
 	d.SetId(s.arn)
 	d.Set("arn", s.arn)
-	d.Set("shard_count", len(s.openShards))
+	if s.streamMode == kinesis.StreamModeProvisioned {
+		d.Set("shard_count", len(s.openShards))
+	}
 
 	return resourceStreamUpdate(d, meta)
 }
@@ -166,6 +209,9 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := updateKinesisStreamMode(conn, d); err != nil {
+		return err
+	}
 	if err := updateKinesisShardCount(conn, d); err != nil {
 		return err
 	}
@@ -204,9 +250,17 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(state.arn)
 	d.Set("arn", state.arn)
-	d.Set("shard_count", len(state.openShards))
+	if state.streamMode == kinesis.StreamModeProvisioned {
+		d.Set("shard_count", len(state.openShards))
+	} else {
+		d.Set("shard_count", nil)
+	}
 	d.Set("retention_period", state.retentionPeriod)
 
+	if err := d.Set("stream_mode_details", []interface{}{map[string]interface{}{"stream_mode": state.streamMode}}); err != nil {
+		return fmt.Errorf("error setting stream_mode_details: %w", err)
+	}
+
 	d.Set("encryption_type", state.encryptionType)
 	d.Set("kms_key_id", state.keyId)
 
@@ -305,9 +359,41 @@ func setKinesisRetentionPeriod(conn *kinesis.Kinesis, d *schema.ResourceData) er
 	return nil
 }
 
+func updateKinesisStreamMode(conn *kinesis.Kinesis, d *schema.ResourceData) error {
+	sn := d.Get("name").(string)
+
+	if d.IsNewResource() || !d.HasChange("stream_mode_details.0.stream_mode") {
+		return nil
+	}
+
+	streamMode := getStreamMode(d)
+
+	log.Printf("[DEBUG] Change %s Stream Mode to %s", sn, streamMode)
+	_, err := conn.UpdateStreamMode(&kinesis.UpdateStreamModeInput{
+		StreamARN: aws.String(d.Id()),
+		StreamModeDetails: &kinesis.StreamModeDetails{
+			StreamMode: aws.String(streamMode),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Kinesis Stream (%s) stream mode: %w", sn, err)
+	}
+
+	if err := WaitForToBeActive(conn, d.Timeout(schema.TimeoutUpdate), sn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func updateKinesisShardCount(conn *kinesis.Kinesis, d *schema.ResourceData) error {
 	sn := d.Get("name").(string)
 
+	if getStreamMode(d) == kinesis.StreamModeOnDemand {
+		log.Printf("[DEBUG] Kinesis Stream (%q) is in %s mode, not updating Shard Count", sn, kinesis.StreamModeOnDemand)
+		return nil
+	}
+
 	oraw, nraw := d.GetChange("shard_count")
 	o := oraw.(int)
 	n := nraw.(int)
@@ -456,6 +542,7 @@ type kinesisStreamState struct {
 	shardLevelMetrics []string
 	encryptionType    string
 	keyId             string
+	streamMode        string
 }
 
 func readKinesisStreamState(conn *kinesis.Kinesis, sn string) (*kinesisStreamState, error) {
@@ -479,6 +566,12 @@ func readKinesisStreamState(conn *kinesis.Kinesis, sn string) (*kinesisStreamSta
 			state.encryptionType = kinesis.EncryptionTypeNone
 		}
 		state.keyId = aws.StringValue(page.StreamDescription.KeyId)
+		// StreamModeDetails is not returned for streams created before on-demand mode was introduced.
+		if page.StreamDescription.StreamModeDetails != nil {
+			state.streamMode = aws.StringValue(page.StreamDescription.StreamModeDetails.StreamMode)
+		} else {
+			state.streamMode = kinesis.StreamModeProvisioned
+		}
 		return !lastPage
 	})
 	return state, err
@@ -540,3 +633,18 @@ func FlattenShards(shards []*kinesis.Shard) []string {
 	}
 	return res
 }
+
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// getStreamMode returns the configured stream mode, defaulting to PROVISIONED.
+func getStreamMode(d resourceGetter) string {
+	streamMode, ok := d.Get("stream_mode_details.0.stream_mode").(string)
+
+	if !ok || streamMode == "" {
+		return kinesis.StreamModeProvisioned
+	}
+
+	return streamMode
+}
@@ -59,6 +59,19 @@ func DataSourceStream() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"stream_mode_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stream_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -84,6 +97,10 @@ func dataSourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("retention_period", state.retentionPeriod)
 	d.Set("shard_level_metrics", state.shardLevelMetrics)
 
+	if err := d.Set("stream_mode_details", []interface{}{map[string]interface{}{"stream_mode": state.streamMode}}); err != nil {
+		return fmt.Errorf("error setting stream_mode_details: %w", err)
+	}
+
 	tags, err := ListTags(conn, sn)
 
 	if err != nil {
@@ -395,6 +395,64 @@ func TestAccKinesisStream_tags(t *testing.T) {
 	})
 }
 
+func TestAccKinesisStream_streamMode(t *testing.T) {
+	var stream kinesis.StreamDescription
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_kinesis_stream.test"
+	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKinesisStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisStreamConfigStreamMode(rInt, 1, kinesis.StreamModeOnDemand),
+				ExpectError: regexp.MustCompile(`shard_count must not be set when stream_mode is ON_DEMAND`),
+			},
+			{
+				Config:      testAccKinesisStreamConfigStreamMode(rInt, 0, kinesis.StreamModeProvisioned),
+				ExpectError: regexp.MustCompile(`shard_count must be at least 1 when stream_mode is PROVISIONED`),
+			},
+			{
+				Config: testAccKinesisStreamConfigStreamMode(rInt, 0, kinesis.StreamModeOnDemand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stream_mode_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stream_mode_details.0.stream_mode", kinesis.StreamModeOnDemand),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           streamName,
+				ImportStateVerifyIgnore: []string{"enforce_consumer_deletion"},
+			},
+			{
+				Config: testAccKinesisStreamConfigStreamMode(rInt, 1, kinesis.StreamModeProvisioned),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stream_mode_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stream_mode_details.0.stream_mode", kinesis.StreamModeProvisioned),
+				),
+			},
+			{
+				Config: testAccKinesisStreamConfigStreamMode(rInt, 0, kinesis.StreamModeOnDemand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stream_mode_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stream_mode_details.0.stream_mode", kinesis.StreamModeOnDemand),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKinesisStreamExists(n string, stream *kinesis.StreamDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -616,6 +674,25 @@ resource "aws_kinesis_stream" "test" {
 `, rInt)
 }
 
+func testAccKinesisStreamConfigStreamMode(rInt, shardCount int, streamMode string) string {
+	shardCountConfig := ""
+	if shardCount > 0 {
+		shardCountConfig = fmt.Sprintf("shard_count = %d", shardCount)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name = "terraform-kinesis-test-%[1]d"
+
+  %[2]s
+
+  stream_mode_details {
+    stream_mode = %[3]q
+  }
+}
+`, rInt, shardCountConfig, streamMode)
+}
+
 func testAccKinesisStreamConfigUpdateRetentionPeriod(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_kinesis_stream" "test" {
@@ -0,0 +1,176 @@
+package kms_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKMSCustomKeyStore_basic(t *testing.T) {
+	resourceName := "aws_kms_custom_key_store.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clusterID, certificate := testAccCustomKeyStoreEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig(rName, clusterID, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_update(t *testing.T) {
+	resourceName := "aws_kms_custom_key_store.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clusterID, certificate := testAccCustomKeyStoreEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig(rName, clusterID, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig(rNameUpdated, clusterID, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_disappears(t *testing.T) {
+	resourceName := "aws_kms_custom_key_store.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clusterID, certificate := testAccCustomKeyStoreEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig(rName, clusterID, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkms.ResourceCustomKeyStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCustomKeyStoreEnv returns the ID of an initialized CloudHSM cluster and the contents of its trust anchor certificate.
+func testAccCustomKeyStoreEnv(t *testing.T) (string, string) {
+	clusterID := os.Getenv("CLOUD_HSM_CLUSTER_ID")
+	certificatePath := os.Getenv("TRUST_ANCHOR_CERTIFICATE")
+
+	if clusterID == "" || certificatePath == "" {
+		t.Skip("CLOUD_HSM_CLUSTER_ID and TRUST_ANCHOR_CERTIFICATE env vars must be set for KMS Custom Key Store acceptance tests. " +
+			"This requires an initialized AWS CloudHSM cluster.")
+	}
+
+	certificate, err := ioutil.ReadFile(certificatePath)
+
+	if err != nil {
+		t.Fatalf("error reading trust anchor certificate (%s): %s", certificatePath, err)
+	}
+
+	return clusterID, string(certificate)
+}
+
+func testAccCheckCustomKeyStoreExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Custom Key Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCustomKeyStoreConfig(rName, clusterID, certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id  = %[2]q
+  custom_key_store_name = %[1]q
+  key_store_password    = "noplaintextpasswords1"
+
+  trust_anchor_certificate = <<-EOT
+%[3]s
+  EOT
+}
+`, rName, clusterID, certificate)
+}
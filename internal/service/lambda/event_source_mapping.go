@@ -14,8 +14,10 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -122,12 +124,14 @@ func ResourceEventSourceMapping() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							MaxItems: 5,
+							Set:      lambdaFilterHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"pattern": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringLenBetween(0, 4096),
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringLenBetween(0, 4096),
+										DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 									},
 								},
 							},
@@ -943,3 +947,21 @@ func flattenLambdaFilter(apiObject *lambda.Filter) map[string]interface{} {
 
 	return tfMap
 }
+
+// lambdaFilterHash hashes the normalized filter pattern so that JSON-equivalent
+// patterns with different formatting map to the same set element.
+func lambdaFilterHash(v interface{}) int {
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	pattern, _ := tfMap["pattern"].(string)
+
+	if v, err := structure.NormalizeJsonString(pattern); err == nil {
+		pattern = v
+	}
+
+	return create.StringHashcode(pattern)
+}
@@ -883,6 +883,7 @@ func TestAccLambdaEventSourceMapping_SQS_filterCriteria(t *testing.T) {
 	resourceName := "aws_lambda_event_source_mapping.test"
 	pattern1 := "{\"Region\": [{\"prefix\": \"us-\"}]}"
 	pattern2 := "{\"Location\": [\"New York\"], \"Day\": [\"Monday\"]}"
+	pattern1Reformatted := "{\"Region\":[{\"prefix\":\"us-\"}]}"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -943,6 +944,10 @@ func TestAccLambdaEventSourceMapping_SQS_filterCriteria(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.filter.*", map[string]string{"pattern": pattern1}),
 				),
 			},
+			{
+				Config:   testAccEventSourceMappingSQSFilterCriteria_1(rName, pattern1Reformatted),
+				PlanOnly: true,
+			},
 		},
 	})
 }
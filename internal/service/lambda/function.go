@@ -1097,6 +1097,12 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 				return resource.RetryableError(err)
 			}
 
+			// A previous update (e.g. from another apply) may not have completed yet.
+			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "in progress") {
+				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
+				return resource.RetryableError(err)
+			}
+
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -1183,7 +1189,25 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Send Update Lambda Function Code request: %#v", codeReq)
 
-		_, err := conn.UpdateFunctionCode(codeReq)
+		err := resource.Retry(lambdaFunctionUpdateTimeout, func() *resource.RetryError {
+			_, err := conn.UpdateFunctionCode(codeReq)
+
+			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "in progress") {
+				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionCode", err)
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			_, err = conn.UpdateFunctionCode(codeReq)
+		}
+
 		if err != nil {
 			return fmt.Errorf("error modifying Lambda Function (%s) Code: %w", d.Id(), err)
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceClassificationJob() *schema.Resource {
@@ -29,6 +30,7 @@ func ResourceClassificationJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: verify.SetTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"custom_data_identifier_ids": {
 				Type:     schema.TypeList,
@@ -494,10 +496,6 @@ func resourceMacie2ClassificationJobRead(ctx context.Context, d *schema.Resource
 func resourceMacie2ClassificationJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Macie2Conn
 
-	input := &macie2.UpdateClassificationJobInput{
-		JobId: aws.String(d.Id()),
-	}
-
 	if d.HasChange("job_status") {
 		status := d.Get("job_status").(string)
 
@@ -505,12 +503,23 @@ func resourceMacie2ClassificationJobUpdate(ctx context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %s", d.Id(), fmt.Sprintf("%s cannot be set", macie2.JobStatusCancelled)))
 		}
 
-		input.JobStatus = aws.String(status)
+		input := &macie2.UpdateClassificationJobInput{
+			JobId:     aws.String(d.Id()),
+			JobStatus: aws.String(status),
+		}
+
+		_, err := conn.UpdateClassificationJobWithContext(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %w", d.Id(), err))
+		}
 	}
 
-	_, err := conn.UpdateClassificationJobWithContext(ctx, input)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %w", d.Id(), err))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("job_arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s) tags: %w", d.Id(), err))
+		}
 	}
 
 	return resourceMacie2ClassificationJobRead(ctx, d, meta)
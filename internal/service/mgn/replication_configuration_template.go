@@ -0,0 +1,285 @@
+package mgn
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplicationConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReplicationConfigurationTemplateCreate,
+		ReadContext:   resourceReplicationConfigurationTemplateRead,
+		UpdateContext: resourceReplicationConfigurationTemplateUpdate,
+		DeleteContext: resourceReplicationConfigurationTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_default_security_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"bandwidth_throttling": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"create_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"data_plane_routing": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mgn.ReplicationConfigurationDataPlaneRoutingPublicIp,
+				ValidateFunc: validation.StringInSlice(mgn.ReplicationConfigurationDataPlaneRouting_Values(), false),
+			},
+			"default_large_staging_disk_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mgn.ReplicationConfigurationDefaultLargeStagingDiskTypeGp2,
+				ValidateFunc: validation.StringInSlice(mgn.ReplicationConfigurationDefaultLargeStagingDiskType_Values(), false),
+			},
+			"ebs_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mgn.ReplicationConfigurationEbsEncryptionDefault,
+				ValidateFunc: validation.StringInSlice(mgn.ReplicationConfigurationEbsEncryption_Values(), false),
+			},
+			"ebs_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"replication_server_instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"replication_servers_security_groups_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"staging_area_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"staging_area_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"use_dedicated_replication_server": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReplicationConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &mgn.CreateReplicationConfigurationTemplateInput{
+		AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+		BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+		CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+		DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+		DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+		EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+		ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+		ReplicationServersSecurityGroupsIDs: flex.ExpandStringSet(d.Get("replication_servers_security_groups_ids").(*schema.Set)),
+		StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+		StagingAreaTags:                     flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{})),
+		UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+	}
+
+	if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+		input.EbsEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MGN Replication Configuration Template: %s", input)
+	output, err := conn.CreateReplicationConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MGN Replication Configuration Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationConfigurationTemplateID))
+
+	return resourceReplicationConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceReplicationConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindReplicationConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Replication Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MGN Replication Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("associate_default_security_group", template.AssociateDefaultSecurityGroup)
+	d.Set("bandwidth_throttling", template.BandwidthThrottling)
+	d.Set("create_public_ip", template.CreatePublicIP)
+	d.Set("data_plane_routing", template.DataPlaneRouting)
+	d.Set("default_large_staging_disk_type", template.DefaultLargeStagingDiskType)
+	d.Set("ebs_encryption", template.EbsEncryption)
+	d.Set("ebs_encryption_key_arn", template.EbsEncryptionKeyArn)
+	d.Set("replication_server_instance_type", template.ReplicationServerInstanceType)
+	d.Set("replication_servers_security_groups_ids", aws.StringValueSlice(template.ReplicationServersSecurityGroupsIDs))
+	d.Set("staging_area_subnet_id", template.StagingAreaSubnetId)
+	d.Set("staging_area_tags", aws.StringValueMap(template.StagingAreaTags))
+	d.Set("use_dedicated_replication_server", template.UseDedicatedReplicationServer)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceReplicationConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mgn.UpdateReplicationConfigurationTemplateInput{
+			ReplicationConfigurationTemplateID: aws.String(d.Id()),
+		}
+
+		if d.HasChange("associate_default_security_group") {
+			input.AssociateDefaultSecurityGroup = aws.Bool(d.Get("associate_default_security_group").(bool))
+		}
+
+		if d.HasChange("bandwidth_throttling") {
+			input.BandwidthThrottling = aws.Int64(int64(d.Get("bandwidth_throttling").(int)))
+		}
+
+		if d.HasChange("create_public_ip") {
+			input.CreatePublicIP = aws.Bool(d.Get("create_public_ip").(bool))
+		}
+
+		if d.HasChange("data_plane_routing") {
+			input.DataPlaneRouting = aws.String(d.Get("data_plane_routing").(string))
+		}
+
+		if d.HasChange("default_large_staging_disk_type") {
+			input.DefaultLargeStagingDiskType = aws.String(d.Get("default_large_staging_disk_type").(string))
+		}
+
+		if d.HasChanges("ebs_encryption", "ebs_encryption_key_arn") {
+			ebsEncryption := d.Get("ebs_encryption").(string)
+			input.EbsEncryption = aws.String(ebsEncryption)
+
+			if ebsEncryption == mgn.ReplicationConfigurationEbsEncryptionDefault {
+				// An empty key ARN clears any previously configured customer managed key.
+				input.EbsEncryptionKeyArn = aws.String("")
+			} else if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+				input.EbsEncryptionKeyArn = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("replication_server_instance_type") {
+			input.ReplicationServerInstanceType = aws.String(d.Get("replication_server_instance_type").(string))
+		}
+
+		if d.HasChange("replication_servers_security_groups_ids") {
+			input.ReplicationServersSecurityGroupsIDs = flex.ExpandStringSet(d.Get("replication_servers_security_groups_ids").(*schema.Set))
+		}
+
+		if d.HasChange("staging_area_subnet_id") {
+			input.StagingAreaSubnetId = aws.String(d.Get("staging_area_subnet_id").(string))
+		}
+
+		if d.HasChange("staging_area_tags") {
+			input.StagingAreaTags = flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{}))
+		}
+
+		if d.HasChange("use_dedicated_replication_server") {
+			input.UseDedicatedReplicationServer = aws.Bool(d.Get("use_dedicated_replication_server").(bool))
+		}
+
+		log.Printf("[DEBUG] Updating MGN Replication Configuration Template: %s", input)
+		_, err := conn.UpdateReplicationConfigurationTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating MGN Replication Configuration Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MGN Replication Configuration Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceReplicationConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceReplicationConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn
+
+	log.Printf("[DEBUG] Deleting MGN Replication Configuration Template: %s", d.Id())
+	_, err := conn.DeleteReplicationConfigurationTemplateWithContext(ctx, &mgn.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MGN Replication Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package opensearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPackageByID(conn *opensearch.OpenSearchService, id string) (*opensearch.PackageDetails, error) {
+	input := &opensearch.DescribePackagesInput{
+		Filters: []*opensearch.DescribePackagesFilter{
+			{
+				Name:  aws.String(opensearch.DescribePackagesFilterNamePackageId),
+				Value: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribePackages(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearch.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.PackageDetailsList) == 0 || output.PackageDetailsList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.PackageDetailsList); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	packageDetails := output.PackageDetailsList[0]
+
+	if status := aws.StringValue(packageDetails.PackageStatus); status == opensearch.PackageStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return packageDetails, nil
+}
+
+func FindPackageAssociationByTwoPartKey(conn *opensearch.OpenSearchService, domainName, packageID string) (*opensearch.DomainPackageDetails, error) {
+	input := &opensearch.ListPackagesForDomainInput{
+		DomainName: aws.String(domainName),
+	}
+	var output *opensearch.DomainPackageDetails
+
+	err := conn.ListPackagesForDomainPages(input, func(page *opensearch.ListPackagesForDomainOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if v != nil && aws.StringValue(v.PackageID) == packageID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearch.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
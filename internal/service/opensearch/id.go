@@ -0,0 +1,25 @@
+package opensearch
+
+import (
+	"fmt"
+	"strings"
+)
+
+const packageAssociationResourceIDSeparator = "/"
+
+func PackageAssociationCreateResourceID(domainName, packageID string) string {
+	parts := []string{domainName, packageID}
+	id := strings.Join(parts, packageAssociationResourceIDSeparator)
+
+	return id
+}
+
+func PackageAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, packageAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAINNAME%[2]sPACKAGEID", id, packageAssociationResourceIDSeparator)
+}
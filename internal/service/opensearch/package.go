@@ -0,0 +1,197 @@
+package opensearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePackage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePackageCreate,
+		Read:   resourcePackageRead,
+		Update: resourcePackageUpdate,
+		Delete: resourcePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"available_package_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 28),
+			},
+			"package_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"s3_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"package_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(opensearch.PackageType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourcePackageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	name := d.Get("package_name").(string)
+	input := &opensearch.CreatePackageInput{
+		PackageName:   aws.String(name),
+		PackageSource: expandPackageSource(d.Get("package_source").([]interface{})),
+		PackageType:   aws.String(d.Get("package_type").(string)),
+	}
+
+	if v, ok := d.GetOk("package_description"); ok {
+		input.PackageDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Package: %s", input)
+	output, err := conn.CreatePackage(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Package (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PackageDetails.PackageID))
+
+	if _, err := waitPackageAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Package (%s) create: %w", d.Id(), err)
+	}
+
+	return resourcePackageRead(d, meta)
+}
+
+func resourcePackageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	pkg, err := FindPackageByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Package (%s): %w", d.Id(), err)
+	}
+
+	d.Set("available_package_version", pkg.AvailablePackageVersion)
+	d.Set("package_description", pkg.PackageDescription)
+	d.Set("package_id", pkg.PackageID)
+	d.Set("package_name", pkg.PackageName)
+	d.Set("package_type", pkg.PackageType)
+
+	// The package source is not returned by the API.
+
+	return nil
+}
+
+func resourcePackageUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	if d.HasChanges("package_description", "package_source") {
+		input := &opensearch.UpdatePackageInput{
+			PackageDescription: aws.String(d.Get("package_description").(string)),
+			PackageID:          aws.String(d.Id()),
+			PackageSource:      expandPackageSource(d.Get("package_source").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating OpenSearch Package: %s", input)
+		_, err := conn.UpdatePackage(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating OpenSearch Package (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitPackageAvailable(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for OpenSearch Package (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePackageRead(d, meta)
+}
+
+func resourcePackageDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Package: %s", d.Id())
+	_, err := conn.DeletePackage(&opensearch.DeletePackageInput{
+		PackageID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Package (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitPackageDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Package (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPackageSource(tfList []interface{}) *opensearch.PackageSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &opensearch.PackageSource{}
+
+	if v, ok := tfMap["s3_bucket_name"].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_key"].(string); ok && v != "" {
+		apiObject.S3Key = aws.String(v)
+	}
+
+	return apiObject
+}
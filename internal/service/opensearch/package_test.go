@@ -0,0 +1,165 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackage_basic(t *testing.T) {
+	var pkg opensearch.PackageDetails
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearch.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig(rName, "test", "synonyms-1.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &pkg),
+					resource.TestCheckResourceAttrSet(resourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "package_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "package_type", opensearch.PackageTypeTxtDictionary),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"package_source"},
+			},
+			{
+				Config: testAccPackageConfig(rName, "updated", "synonyms-2.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &pkg),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "updated"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_source.0.s3_key", "aws_s3_bucket_object.test2", "key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackage_disappears(t *testing.T) {
+	var pkg opensearch.PackageDetails
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearch.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig(rName, "test", "synonyms-1.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &pkg),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourcePackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageExists(n string, v *opensearch.PackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+		output, err := tfopensearch.FindPackageByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPackageDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_package" {
+			continue
+		}
+
+		_, err := tfopensearch.FindPackageByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Package %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "test1" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "synonyms-1.txt"
+  content = "danish, croissant, pastry"
+}
+
+resource "aws_s3_bucket_object" "test2" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "synonyms-2.txt"
+  content = "ice cream, gelato, frozen custard"
+}
+`, rName)
+}
+
+func testAccPackageConfig(rName, description, key string) string {
+	return acctest.ConfigCompose(testAccPackageBaseConfig(rName), fmt.Sprintf(`
+resource "aws_opensearch_package" "test" {
+  package_name        = %[1]q
+  package_description = %[2]q
+  package_type        = "TXT-DICTIONARY"
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = %[3]q
+  }
+
+  depends_on = [aws_s3_bucket_object.test1, aws_s3_bucket_object.test2]
+}
+`, rName, description, key))
+}
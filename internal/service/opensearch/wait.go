@@ -0,0 +1,106 @@
+package opensearch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	packageAvailableTimeout = 20 * time.Minute
+	packageDeletedTimeout   = 20 * time.Minute
+
+	packageAssociationCreatedTimeout = 60 * time.Minute
+	packageAssociationDeletedTimeout = 60 * time.Minute
+)
+
+func waitPackageAvailable(conn *opensearch.OpenSearchService, id string) (*opensearch.PackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearch.PackageStatusCopying,
+			opensearch.PackageStatusValidating,
+		},
+		Target:  []string{opensearch.PackageStatusAvailable},
+		Refresh: statusPackage(conn, id),
+		Timeout: packageAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.PackageDetails); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.ErrorDetails))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageDeleted(conn *opensearch.OpenSearchService, id string) (*opensearch.PackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearch.PackageStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPackage(conn, id),
+		Timeout: packageDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.PackageDetails); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.ErrorDetails))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationCreated(conn *opensearch.OpenSearchService, domainName, packageID string) (*opensearch.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearch.DomainPackageStatusAssociating},
+		Target:  []string{opensearch.DomainPackageStatusActive},
+		Refresh: statusPackageAssociation(conn, domainName, packageID),
+		Timeout: packageAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.DomainPackageDetails); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.ErrorDetails))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationDeleted(conn *opensearch.OpenSearchService, domainName, packageID string) (*opensearch.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearch.DomainPackageStatusDissociating},
+		Target:  []string{},
+		Refresh: statusPackageAssociation(conn, domainName, packageID),
+		Timeout: packageAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.DomainPackageDetails); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.ErrorDetails))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func errorDetailsError(apiObject *opensearch.ErrorDetails) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorType), aws.StringValue(apiObject.ErrorMessage))
+}
@@ -0,0 +1,35 @@
+package rds
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// startActivityStream starts a database activity stream for the DB cluster or DB instance with the specified ARN.
+func startActivityStream(conn *rds.RDS, arn, kmsKeyID, mode string, engineNativeAuditFieldsIncluded *bool) error {
+	input := &rds.StartActivityStreamInput{
+		ApplyImmediately:                aws.Bool(true),
+		EngineNativeAuditFieldsIncluded: engineNativeAuditFieldsIncluded,
+		KmsKeyId:                        aws.String(kmsKeyID),
+		Mode:                            aws.String(mode),
+		ResourceArn:                     aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Starting RDS Activity Stream: %s", input)
+	_, err := conn.StartActivityStream(input)
+
+	return err
+}
+
+// stopActivityStream stops the database activity stream for the DB cluster or DB instance with the specified ARN.
+func stopActivityStream(conn *rds.RDS, arn string) error {
+	log.Printf("[DEBUG] Stopping RDS Activity Stream: %s", arn)
+	_, err := conn.StopActivityStream(&rds.StopActivityStreamInput{
+		ApplyImmediately: aws.Bool(true),
+		ResourceArn:      aws.String(arn),
+	})
+
+	return err
+}
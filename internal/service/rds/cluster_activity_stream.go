@@ -0,0 +1,111 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceClusterActivityStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterActivityStreamCreate,
+		Read:   resourceClusterActivityStreamRead,
+		Delete: resourceClusterActivityStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"kinesis_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceClusterActivityStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	arn := d.Get("resource_arn").(string)
+
+	if err := startActivityStream(conn, arn, d.Get("kms_key_id").(string), d.Get("mode").(string), nil); err != nil {
+		return fmt.Errorf("error starting RDS Cluster (%s) Activity Stream: %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	if _, err := waitDBClusterActivityStreamStarted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster (%s) Activity Stream to start: %w", d.Id(), err)
+	}
+
+	return resourceClusterActivityStreamRead(d, meta)
+}
+
+func resourceClusterActivityStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	output, err := FindDBClusterWithActivityStream(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Cluster (%s) Activity Stream not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	d.Set("kinesis_stream_name", output.ActivityStreamKinesisStreamName)
+	d.Set("kms_key_id", output.ActivityStreamKmsKeyId)
+	d.Set("mode", output.ActivityStreamMode)
+	d.Set("resource_arn", output.DBClusterArn)
+
+	return nil
+}
+
+func resourceClusterActivityStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	err := stopActivityStream(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS Cluster (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	if _, err := waitDBClusterActivityStreamStopped(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster (%s) Activity Stream to stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
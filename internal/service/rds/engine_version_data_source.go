@@ -42,6 +42,13 @@ func DataSourceEngineVersion() *schema.Resource {
 				Optional: true,
 			},
 
+			"preferred_upgrade_targets": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"version"},
+			},
+
 			"preferred_versions": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -145,7 +152,10 @@ func dataSourceEngineVersionRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if _, ok := d.GetOk("version"); !ok {
-		if _, ok := d.GetOk("preferred_versions"); !ok {
+		_, preferredVersions := d.GetOk("preferred_versions")
+		_, preferredUpgradeTargets := d.GetOk("preferred_upgrade_targets")
+
+		if !preferredVersions && !preferredUpgradeTargets {
 			input.DefaultOnly = aws.Bool(true)
 		}
 	}
@@ -175,6 +185,8 @@ func dataSourceEngineVersionRead(d *schema.ResourceData, meta interface{}) error
 	// preferred versions
 	var found *rds.DBEngineVersion
 	if l := d.Get("preferred_versions").([]interface{}); len(l) > 0 {
+		var preferred []*rds.DBEngineVersion
+
 		for _, elem := range l {
 			preferredVersion, ok := elem.(string)
 
@@ -184,7 +196,38 @@ func dataSourceEngineVersionRead(d *schema.ResourceData, meta interface{}) error
 
 			for _, engineVersion := range engineVersions {
 				if preferredVersion == aws.StringValue(engineVersion.EngineVersion) {
-					found = engineVersion
+					preferred = append(preferred, engineVersion)
+					break
+				}
+			}
+		}
+
+		if len(preferred) > 0 {
+			found = preferred[0]
+			engineVersions = preferred
+		}
+	}
+
+	// preferred upgrade targets
+	if l := d.Get("preferred_upgrade_targets").([]interface{}); len(l) > 0 {
+		found = nil
+
+		for _, elem := range l {
+			preferredUpgradeTarget, ok := elem.(string)
+
+			if !ok {
+				continue
+			}
+
+			for _, engineVersion := range engineVersions {
+				for _, upgradeTarget := range engineVersion.ValidUpgradeTarget {
+					if preferredUpgradeTarget == aws.StringValue(upgradeTarget.EngineVersion) {
+						found = engineVersion
+						break
+					}
+				}
+
+				if found != nil {
 					break
 				}
 			}
@@ -193,6 +236,10 @@ func dataSourceEngineVersionRead(d *schema.ResourceData, meta interface{}) error
 				break
 			}
 		}
+
+		if found == nil {
+			return fmt.Errorf("no RDS engine versions match the preferred upgrade targets")
+		}
 	}
 
 	if found == nil && len(engineVersions) > 1 {
@@ -88,6 +88,26 @@ func TestAccRDSEngineVersionDataSource_preferred(t *testing.T) {
 	})
 }
 
+func TestAccRDSEngineVersionDataSource_preferredUpgradeTargets(t *testing.T) {
+	dataSourceName := "data.aws_rds_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccEngineVersionPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionPreferredUpgradeTargetsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "version", "5.7.19"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "valid_upgrade_targets.*", "5.7.37"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSEngineVersionDataSource_defaultOnly(t *testing.T) {
 	dataSourceName := "data.aws_rds_engine_version.test"
 
@@ -154,6 +174,16 @@ data "aws_rds_engine_version" "test" {
 `
 }
 
+func testAccEngineVersionPreferredUpgradeTargetsDataSourceConfig() string {
+	return `
+data "aws_rds_engine_version" "test" {
+  engine                    = "mysql"
+  preferred_versions        = ["85.9.12", "5.7.19", "5.7.17"]
+  preferred_upgrade_targets = ["85.9.12", "5.7.37"]
+}
+`
+}
+
 func testAccEngineVersionDefaultOnlyDataSourceConfig() string {
 	return `
 data "aws_rds_engine_version" "test" {
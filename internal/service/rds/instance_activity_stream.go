@@ -0,0 +1,119 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceActivityStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceActivityStreamCreate,
+		Read:   resourceInstanceActivityStreamRead,
+		Delete: resourceInstanceActivityStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine_native_audit_fields_included": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"kinesis_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceInstanceActivityStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	arn := d.Get("resource_arn").(string)
+
+	if err := startActivityStream(conn, arn, d.Get("kms_key_id").(string), d.Get("mode").(string), aws.Bool(d.Get("engine_native_audit_fields_included").(bool))); err != nil {
+		return fmt.Errorf("error starting RDS DB Instance (%s) Activity Stream: %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	if _, err := waitDBInstanceActivityStreamStarted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance (%s) Activity Stream to start: %w", d.Id(), err)
+	}
+
+	return resourceInstanceActivityStreamRead(d, meta)
+}
+
+func resourceInstanceActivityStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	output, err := FindDBInstanceWithActivityStream(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance (%s) Activity Stream not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	d.Set("engine_native_audit_fields_included", output.ActivityStreamEngineNativeAuditFieldsIncluded)
+	d.Set("kinesis_stream_name", output.ActivityStreamKinesisStreamName)
+	d.Set("kms_key_id", output.ActivityStreamKmsKeyId)
+	d.Set("mode", output.ActivityStreamMode)
+	d.Set("resource_arn", output.DBInstanceArn)
+
+	return nil
+}
+
+func resourceInstanceActivityStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	err := stopActivityStream(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS DB Instance (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	if _, err := waitDBInstanceActivityStreamStopped(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance (%s) Activity Stream to stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
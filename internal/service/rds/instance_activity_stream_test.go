@@ -0,0 +1,148 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSInstanceActivityStream_basic(t *testing.T) {
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_activity_stream.test"
+	instanceResourceName := "aws_db_instance.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceActivityStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceActivityStreamExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", instanceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "mode", rds.ActivityStreamModeAsync),
+					resource.TestCheckResourceAttr(resourceName, "engine_native_audit_fields_included", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "kinesis_stream_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceActivityStream_disappears(t *testing.T) {
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_activity_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceActivityStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceActivityStreamExists(resourceName, &dbInstance),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceInstanceActivityStream(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceActivityStreamExists(resourceName string, v *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS DB Instance Activity Stream ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindDBInstanceWithActivityStream(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceActivityStreamDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance_activity_stream" {
+			continue
+		}
+
+		_, err := tfrds.FindDBInstanceWithActivityStream(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS DB Instance Activity Stream %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInstanceActivityStreamConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  identifier          = %[1]q
+  allocated_storage   = 20
+  engine              = "oracle-ee"
+  instance_class      = "db.m5.large"
+  license_model       = "bring-your-own-license"
+  username            = "foo"
+  password            = "foobarfoobarfoobar"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_instance_activity_stream" "test" {
+  resource_arn                        = aws_db_instance.test.arn
+  mode                                = "async"
+  kms_key_id                          = aws_kms_key.test.key_id
+  engine_native_audit_fields_included = true
+}
+`, rName)
+}
@@ -0,0 +1,25 @@
+package rekognition
+
+import (
+	"fmt"
+	"strings"
+)
+
+const projectVersionResourceIDSeparator = ","
+
+func ProjectVersionCreateResourceID(projectARN, versionName string) string {
+	parts := []string{projectARN, versionName}
+	id := strings.Join(parts, projectVersionResourceIDSeparator)
+
+	return id
+}
+
+func ProjectVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, projectVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PROJECTARN%[2]sVERSIONNAME", id, projectVersionResourceIDSeparator)
+}
@@ -0,0 +1,516 @@
+package rekognition
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProjectVersion() *schema.Resource {
+	assetSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ground_truth_manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_object": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceProjectVersionCreate,
+		Read:   resourceProjectVersionRead,
+		Update: resourceProjectVersionUpdate,
+		Delete: resourceProjectVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"s3_key_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"testing_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     assetSchema,
+						},
+						"auto_create": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"training_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     assetSchema,
+						},
+					},
+				},
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProjectVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	projectARN := d.Get("project_arn").(string)
+	versionName := d.Get("version_name").(string)
+	input := &rekognition.CreateProjectVersionInput{
+		OutputConfig: expandOutputConfig(d.Get("output_config").([]interface{})),
+		ProjectArn:   aws.String(projectARN),
+		VersionName:  aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("testing_data"); ok && len(v.([]interface{})) > 0 {
+		input.TestingData = expandTestingData(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("training_data"); ok && len(v.([]interface{})) > 0 {
+		input.TrainingData = expandTrainingData(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Rekognition Project Version: %s", input)
+	_, err := conn.CreateProjectVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Rekognition Project Version (%s): %w", versionName, err)
+	}
+
+	d.SetId(ProjectVersionCreateResourceID(projectARN, versionName))
+
+	if _, err := waitProjectVersionCreated(conn, projectARN, versionName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Rekognition Project Version (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceProjectVersionRead(d, meta)
+}
+
+func resourceProjectVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindProjectVersionByTwoPartKey(conn, projectARN, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Rekognition Project Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.ProjectVersionArn)
+	d.Set("arn", arn)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("project_arn", projectARN)
+	d.Set("status", output.Status)
+	d.Set("version_name", versionName)
+
+	if err := d.Set("output_config", flattenOutputConfig(output.OutputConfig)); err != nil {
+		return fmt.Errorf("error setting output_config: %w", err)
+	}
+
+	if output.TestingDataResult != nil {
+		if err := d.Set("testing_data", flattenTestingData(output.TestingDataResult.Input)); err != nil {
+			return fmt.Errorf("error setting testing_data: %w", err)
+		}
+	} else {
+		d.Set("testing_data", nil)
+	}
+
+	if output.TrainingDataResult != nil {
+		if err := d.Set("training_data", flattenTrainingData(output.TrainingDataResult.Input)); err != nil {
+			return fmt.Errorf("error setting training_data: %w", err)
+		}
+	} else {
+		d.Set("training_data", nil)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Rekognition Project Version (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceProjectVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Rekognition Project Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceProjectVersionRead(d, meta)
+}
+
+func resourceProjectVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Rekognition Project Version: %s", d.Id())
+	_, err = conn.DeleteProjectVersion(&rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: aws.String(d.Get("arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Rekognition Project Version (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitProjectVersionDeleted(conn, projectARN, versionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Rekognition Project Version (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOutputConfig(tfList []interface{}) *rekognition.OutputConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &rekognition.OutputConfig{}
+
+	if v, ok := tfMap["s3_bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTestingData(tfList []interface{}) *rekognition.TestingData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &rekognition.TestingData{}
+
+	if v, ok := tfMap["asset"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Assets = expandAssets(v)
+	}
+
+	if v, ok := tfMap["auto_create"].(bool); ok {
+		apiObject.AutoCreate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingData(tfList []interface{}) *rekognition.TrainingData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &rekognition.TrainingData{}
+
+	if v, ok := tfMap["asset"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Assets = expandAssets(v)
+	}
+
+	return apiObject
+}
+
+func expandAssets(tfList []interface{}) []*rekognition.Asset {
+	var apiObjects []*rekognition.Asset
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rekognition.Asset{}
+
+		if v, ok := tfMap["ground_truth_manifest"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			manifest := &rekognition.GroundTruthManifest{}
+
+			if v, ok := v[0].(map[string]interface{})["s3_object"].([]interface{}); ok && len(v) > 0 {
+				manifest.S3Object = expandS3Object(v)
+			}
+
+			apiObject.GroundTruthManifest = manifest
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Object(tfList []interface{}) *rekognition.S3Object {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &rekognition.S3Object{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputConfig(apiObject *rekognition.OutputConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket":     aws.StringValue(apiObject.S3Bucket),
+		"s3_key_prefix": aws.StringValue(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTestingData(apiObject *rekognition.TestingData) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"asset":       flattenAssets(apiObject.Assets),
+		"auto_create": aws.BoolValue(apiObject.AutoCreate),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingData(apiObject *rekognition.TrainingData) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"asset": flattenAssets(apiObject.Assets),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAssets(apiObjects []*rekognition.Asset) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.GroundTruthManifest == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"ground_truth_manifest": []interface{}{
+				map[string]interface{}{
+					"s3_object": flattenS3Object(apiObject.GroundTruthManifest.S3Object),
+				},
+			},
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3Object(apiObject *rekognition.S3Object) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":  aws.StringValue(apiObject.Bucket),
+		"name":    aws.StringValue(apiObject.Name),
+		"version": aws.StringValue(apiObject.Version),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,158 @@
+package rekognition_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	manifestBucket := os.Getenv("REKOGNITION_PROJECT_VERSION_MANIFEST_BUCKET")
+	manifestKey := os.Getenv("REKOGNITION_PROJECT_VERSION_MANIFEST_KEY")
+
+	if manifestBucket == "" || manifestKey == "" {
+		t.Skip("Environment variables REKOGNITION_PROJECT_VERSION_MANIFEST_BUCKET and REKOGNITION_PROJECT_VERSION_MANIFEST_KEY must be set")
+	}
+
+	var projectVersion rekognition.ProjectVersionDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rekognition.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProjectVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(resourceName, &projectVersion),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "output_config.0.s3_bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_arn", "aws_rekognition_project.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusTrainingCompleted),
+					resource.TestCheckResourceAttr(resourceName, "testing_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "testing_data.0.auto_create", "true"),
+					resource.TestCheckResourceAttr(resourceName, "training_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "training_data.0.asset.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_project_version" {
+			continue
+		}
+
+		projectARN, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfrekognition.FindProjectVersionByTwoPartKey(conn, projectARN, versionName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Rekognition Project Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckProjectVersionExists(n string, v *rekognition.ProjectVersionDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Project Version ID is set")
+		}
+
+		projectARN, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+		output, err := tfrekognition.FindProjectVersionByTwoPartKey(conn, projectARN, versionName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProjectVersionConfig(rName, manifestBucket, manifestKey string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = aws_s3_bucket.test.bucket
+    s3_key_prefix = "output/"
+  }
+
+  training_data {
+    asset {
+      ground_truth_manifest {
+        s3_object {
+          bucket = %[2]q
+          name   = %[3]q
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, manifestBucket, manifestKey)
+}
@@ -0,0 +1,87 @@
+package rekognition
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitProjectCreated(conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.ProjectDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectStatusCreating},
+		Target:  []string{rekognition.ProjectStatusCreated},
+		Refresh: statusProject(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rekognition.ProjectDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectDeleted(conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.ProjectDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectStatusCreated, rekognition.ProjectStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProject(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rekognition.ProjectDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionCreated(conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusTrainingInProgress},
+		Target:  []string{rekognition.ProjectVersionStatusTrainingCompleted},
+		Refresh: statusProjectVersion(conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			rekognition.ProjectVersionStatusDeleting,
+			rekognition.ProjectVersionStatusFailed,
+			rekognition.ProjectVersionStatusStopped,
+			rekognition.ProjectVersionStatusTrainingCompleted,
+			rekognition.ProjectVersionStatusTrainingFailed,
+		},
+		Target:  []string{},
+		Refresh: statusProjectVersion(conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,186 @@
+package route53
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	// Record sets are returned in lexicographical order of the reversed name labels,
+	// so when a name is requested listing can start there and stop once past it.
+	name := strings.ToLower(FQDN(d.Get("name").(string)))
+	recordType := d.Get("type").(string)
+
+	if name != "" {
+		input.StartRecordName = aws.String(name)
+
+		if recordType != "" {
+			input.StartRecordType = aws.String(recordType)
+		}
+	}
+
+	var recordSets []interface{}
+
+	err := conn.ListResourceRecordSetsPages(input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, recordSet := range page.ResourceRecordSets {
+			if recordSet == nil {
+				continue
+			}
+
+			recordName := strings.ToLower(CleanRecordName(aws.StringValue(recordSet.Name)))
+
+			if name != "" && recordName != name {
+				return false
+			}
+
+			if recordType != "" && aws.StringValue(recordSet.Type) != recordType {
+				continue
+			}
+
+			recordSets = append(recordSets, flattenResourceRecordSet(recordSet))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zone (%s) records: %w", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+
+	if err := d.Set("resource_record_sets", recordSets); err != nil {
+		return fmt.Errorf("error setting resource_record_sets: %w", err)
+	}
+
+	return nil
+}
+
+func flattenResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"health_check_id": aws.StringValue(apiObject.HealthCheckId),
+		"name":            TrimTrailingPeriod(CleanRecordName(aws.StringValue(apiObject.Name))),
+		"set_identifier":  aws.StringValue(apiObject.SetIdentifier),
+		"ttl":             aws.Int64Value(apiObject.TTL),
+		"type":            aws.StringValue(apiObject.Type),
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   TrimTrailingPeriod(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	var records []string
+
+	for _, record := range apiObject.ResourceRecords {
+		if record == nil {
+			continue
+		}
+
+		records = append(records, aws.StringValue(record.Value))
+	}
+
+	tfMap["records"] = records
+
+	return tfMap
+}
@@ -0,0 +1,104 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_records.test"
+	zoneResourceName := "aws_route53_zone.test"
+	fqdn := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig(fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", zoneResourceName, "zone_id"),
+					// NS and SOA at the apex plus the two test records.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_nameAndType(t *testing.T) {
+	dataSourceName := "data.aws_route53_records.test"
+	fqdn := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsNameAndTypeDataSourceConfig(fqdn, fmt.Sprintf("www.%s", fqdn), "A"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", fmt.Sprintf("www.%s", fqdn)),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.0", "127.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsBaseConfig(fqdn string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "a" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www.%[1]s"
+  type    = "TXT"
+  ttl     = 300
+  records = ["test"]
+}
+`, fqdn)
+}
+
+func testAccRecordsDataSourceConfig(fqdn string) string {
+	return acctest.ConfigCompose(testAccRecordsBaseConfig(fqdn), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.a, aws_route53_record.txt]
+}
+`)
+}
+
+func testAccRecordsNameAndTypeDataSourceConfig(fqdn, name, recordType string) string {
+	return acctest.ConfigCompose(testAccRecordsBaseConfig(fqdn), fmt.Sprintf(`
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[1]q
+  type    = %[2]q
+
+  depends_on = [aws_route53_record.a, aws_route53_record.txt]
+}
+`, name, recordType))
+}
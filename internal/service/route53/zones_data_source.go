@@ -0,0 +1,146 @@
+package route53
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"vpc_id"},
+			},
+		},
+	}
+}
+
+func dataSourceZonesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	nameContains := strings.ToLower(d.Get("name_contains").(string))
+	var ids, names []string
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		// Only private hosted zones can be associated with a VPC.
+		if v, ok := d.GetOkExists("private_zone"); ok && !v.(bool) {
+			return setZonesDataSourceAttributes(d, meta, ids, names)
+		}
+
+		vpcRegion := meta.(*conns.AWSClient).Region
+
+		if v, ok := d.GetOk("vpc_region"); ok {
+			vpcRegion = v.(string)
+		}
+
+		input := &route53.ListHostedZonesByVPCInput{
+			VPCId:     aws.String(v.(string)),
+			VPCRegion: aws.String(vpcRegion),
+		}
+
+		for {
+			output, err := conn.ListHostedZonesByVPC(input)
+
+			if err != nil {
+				return fmt.Errorf("error listing Route 53 Hosted Zones by VPC (%s): %w", v.(string), err)
+			}
+
+			for _, hostedZone := range output.HostedZoneSummaries {
+				if hostedZone == nil {
+					continue
+				}
+
+				name := TrimTrailingPeriod(aws.StringValue(hostedZone.Name))
+
+				if nameContains != "" && !strings.Contains(strings.ToLower(name), nameContains) {
+					continue
+				}
+
+				ids = append(ids, CleanZoneID(aws.StringValue(hostedZone.HostedZoneId)))
+				names = append(names, name)
+			}
+
+			if aws.StringValue(output.NextToken) == "" {
+				break
+			}
+
+			input.NextToken = output.NextToken
+		}
+
+		return setZonesDataSourceAttributes(d, meta, ids, names)
+	}
+
+	privateZone, privateZoneExists := d.GetOkExists("private_zone")
+
+	err := conn.ListHostedZonesPages(&route53.ListHostedZonesInput{}, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, hostedZone := range page.HostedZones {
+			if hostedZone == nil {
+				continue
+			}
+
+			if privateZoneExists && hostedZone.Config != nil && aws.BoolValue(hostedZone.Config.PrivateZone) != privateZone.(bool) {
+				continue
+			}
+
+			name := TrimTrailingPeriod(aws.StringValue(hostedZone.Name))
+
+			if nameContains != "" && !strings.Contains(strings.ToLower(name), nameContains) {
+				continue
+			}
+
+			ids = append(ids, CleanZoneID(aws.StringValue(hostedZone.Id)))
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zones: %w", err)
+	}
+
+	return setZonesDataSourceAttributes(d, meta, ids, names)
+}
+
+func setZonesDataSourceAttributes(d *schema.ResourceData, meta interface{}, ids, names []string) error {
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZonesDataSource_nameContains(t *testing.T) {
+	resourceName := "aws_route53_zone.test"
+	dataSourceName := "data.aws_route53_zones.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	fqdn := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonesNameContainsDataSourceConfig(rName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZonesDataSource_vpc(t *testing.T) {
+	resourceName := "aws_route53_zone.test"
+	dataSourceName := "data.aws_route53_zones.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	fqdn := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonesVPCDataSourceConfig(rName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "zone_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZonesNameContainsDataSourceConfig(rName, fqdn string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s.%[2]s"
+}
+
+data "aws_route53_zones" "test" {
+  name_contains = %[1]q
+  private_zone  = false
+
+  depends_on = [aws_route53_zone.test]
+}
+`, rName, fqdn)
+}
+
+func testAccZonesVPCDataSourceConfig(rName, fqdn string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+data "aws_route53_zones" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_route53_zone.test]
+}
+`, rName, fqdn)
+}
@@ -116,6 +116,26 @@ func FindFirewallDomainListByID(conn *route53resolver.Route53Resolver, firewallD
 	return output.FirewallDomainList, nil
 }
 
+// FindFirewallDomainsByListID returns the domains in the DNS Firewall domain list corresponding to the specified ID.
+func FindFirewallDomainsByListID(conn *route53resolver.Route53Resolver, firewallDomainListId string) ([]*string, error) {
+	input := &route53resolver.ListFirewallDomainsInput{
+		FirewallDomainListId: aws.String(firewallDomainListId),
+	}
+
+	domains := []*string{}
+
+	err := conn.ListFirewallDomainsPages(input, func(output *route53resolver.ListFirewallDomainsOutput, lastPage bool) bool {
+		domains = append(domains, output.Domains...)
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
 // FindFirewallConfigByID returns the dnssec configuration corresponding to the specified ID.
 // Returns NotFoundError if no configuration is found.
 func FindFirewallConfigByID(conn *route53resolver.Route53Resolver, firewallConfigID string) (*route53resolver.FirewallConfig, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -39,11 +40,19 @@ func ResourceFirewallDomainList() *schema.Resource {
 			},
 
 			"domains": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MinItems: 0,
-				MaxItems: 255,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      255,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"domain_file_url"},
+			},
+
+			"domain_file_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 1024),
+				ConflictsWith: []string{"domains"},
 			},
 
 			"tags": tftags.TagsSchema(),
@@ -108,23 +117,18 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("arn", arn)
 	d.Set("name", firewallDomainList.Name)
 
-	input := &route53resolver.ListFirewallDomainsInput{
-		FirewallDomainListId: aws.String(d.Id()),
-	}
+	// Domains imported from a file are managed by the file contents rather
+	// than the domains argument.
+	if _, ok := d.GetOk("domain_file_url"); !ok {
+		domains, err := FindFirewallDomainsByListID(conn, d.Id())
 
-	domains := []*string{}
-
-	err = conn.ListFirewallDomainsPages(input, func(output *route53resolver.ListFirewallDomainsOutput, lastPage bool) bool {
-		domains = append(domains, output.Domains...)
-		return !lastPage
-	})
+		if err != nil {
+			return fmt.Errorf("error listing Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("error listing Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
+		d.Set("domains", flex.FlattenStringSet(domains))
 	}
 
-	d.Set("domains", flex.FlattenStringSet(domains))
-
 	tags, err := ListTags(conn, arn)
 	if err != nil {
 		return fmt.Errorf("error listing tags for Route53 Resolver DNS Firewall domain list (%s): %w", arn, err)
@@ -147,6 +151,52 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 func resourceFirewallDomainListUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn
 
+	if d.HasChange("domain_file_url") {
+		if v, ok := d.GetOk("domain_file_url"); ok {
+			_, err := conn.ImportFirewallDomains(&route53resolver.ImportFirewallDomainsInput{
+				DomainFileUrl:        aws.String(v.(string)),
+				FirewallDomainListId: aws.String(d.Id()),
+				Operation:            aws.String(route53resolver.FirewallDomainImportOperationReplace),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error importing Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
+			}
+
+			_, err = WaitFirewallDomainListUpdated(conn, d.Id())
+
+			if err != nil {
+				return fmt.Errorf("error waiting for Route 53 Resolver DNS Firewall domain list (%s) domains to be imported: %w", d.Id(), err)
+			}
+		} else if d.Get("domains").(*schema.Set).Len() == 0 {
+			// The domain file is no longer used and no domains are configured,
+			// so remove the previously imported domains.
+			domains, err := FindFirewallDomainsByListID(conn, d.Id())
+
+			if err != nil {
+				return fmt.Errorf("error listing Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
+			}
+
+			if len(domains) > 0 {
+				_, err = conn.UpdateFirewallDomains(&route53resolver.UpdateFirewallDomainsInput{
+					FirewallDomainListId: aws.String(d.Id()),
+					Domains:              domains,
+					Operation:            aws.String(route53resolver.FirewallDomainUpdateOperationRemove),
+				})
+
+				if err != nil {
+					return fmt.Errorf("error updating Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
+				}
+
+				_, err = WaitFirewallDomainListUpdated(conn, d.Id())
+
+				if err != nil {
+					return fmt.Errorf("error waiting for Route 53 Resolver DNS Firewall domain list (%s) domains to be updated: %w", d.Id(), err)
+				}
+			}
+		}
+	}
+
 	if d.HasChange("domains") {
 		o, n := d.GetChange("domains")
 		if o == nil {
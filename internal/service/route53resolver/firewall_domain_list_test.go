@@ -91,6 +91,56 @@ func TestAccRoute53ResolverFirewallDomainList_domains(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallDomainList_domainFileURL(t *testing.T) {
+	var v route53resolver.FirewallDomainList
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+
+	domainName1 := acctest.RandomFQDomainName()
+	domainName2 := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ResolverFirewallDomainListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverFirewallDomainListConfigDomainFileURL(rName, domainName1, domainName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverFirewallDomainListExists(resourceName, &v),
+					testAccCheckRoute53ResolverFirewallDomainListDomainCount(resourceName, 2),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_file_url"),
+				),
+			},
+			{
+				Config: testAccRoute53ResolverFirewallDomainListConfigDomains(rName, domainName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverFirewallDomainListExists(resourceName, &v),
+					testAccCheckRoute53ResolverFirewallDomainListDomainCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "domain_file_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", domainName1),
+				),
+			},
+			{
+				Config: testAccRoute53ResolverFirewallDomainListConfigDomainFileURL(rName, domainName1, domainName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverFirewallDomainListExists(resourceName, &v),
+					testAccCheckRoute53ResolverFirewallDomainListDomainCount(resourceName, 2),
+				),
+			},
+			{
+				Config: testAccRoute53ResolverFirewallDomainListConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverFirewallDomainListExists(resourceName, &v),
+					testAccCheckRoute53ResolverFirewallDomainListDomainCount(resourceName, 0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallDomainList_disappears(t *testing.T) {
 	var v route53resolver.FirewallDomainList
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -208,6 +258,29 @@ func testAccCheckRoute53ResolverFirewallDomainListExists(n string, v *route53res
 	}
 }
 
+func testAccCheckRoute53ResolverFirewallDomainListDomainCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+
+		domains, err := tfroute53resolver.FindFirewallDomainsByListID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(domains) != expected {
+			return fmt.Errorf("Route 53 Resolver DNS Firewall domain list (%s) has %d domains, expected %d", rs.Primary.ID, len(domains), expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccRoute53ResolverFirewallDomainListConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_resolver_firewall_domain_list" "test" {
@@ -247,3 +320,23 @@ resource "aws_route53_resolver_firewall_domain_list" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccRoute53ResolverFirewallDomainListConfigDomainFileURL(rName, domain1, domain2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "domains.txt"
+  content = "%[2]s\n%[3]s\n"
+}
+
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name            = %[1]q
+  domain_file_url = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+}
+`, rName, domain1, domain2)
+}
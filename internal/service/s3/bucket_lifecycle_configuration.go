@@ -0,0 +1,701 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLifecycleConfigurationCreate,
+		Read:   resourceBucketLifecycleConfigurationRead,
+		Update: resourceBucketLifecycleConfigurationUpdate,
+		Delete: resourceBucketLifecycleConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_size_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validBucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validBucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: expandBucketLifecycleConfigurationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	outputRaw, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketLifecycleConfiguration(input)
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	output, ok := outputRaw.(*s3.GetBucketLifecycleConfigurationOutput)
+
+	if !ok || output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenBucketLifecycleConfigurationRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: expandBucketLifecycleConfigurationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	_, err := conn.PutBucketLifecycleConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Lifecycle Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceBucketLifecycleConfigurationCustomizeDiff rejects filters that specify more than one
+// condition outside of an "and" block, which the API does not accept.
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, rule := range diff.Get("rule").([]interface{}) {
+		tfMap, ok := rule.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filters, ok := tfMap["filter"].([]interface{})
+
+		if !ok || len(filters) == 0 || filters[0] == nil {
+			continue
+		}
+
+		filter := filters[0].(map[string]interface{})
+		n := 0
+
+		if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 {
+			n++
+		}
+
+		if v, ok := filter["object_size_greater_than"].(int); ok && v > 0 {
+			n++
+		}
+
+		if v, ok := filter["object_size_less_than"].(int); ok && v > 0 {
+			n++
+		}
+
+		if v, ok := filter["prefix"].(string); ok && v != "" {
+			n++
+		}
+
+		if v, ok := filter["tag"].([]interface{}); ok && len(v) > 0 {
+			n++
+		}
+
+		if n > 1 {
+			return fmt.Errorf("rule.%d.filter: only one of and, object_size_greater_than, object_size_less_than, prefix, or tag can be specified; use an and block to combine conditions", i)
+		}
+	}
+
+	return nil
+}
+
+func expandBucketLifecycleConfigurationRules(tfList []interface{}) []*s3.LifecycleRule {
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.LifecycleRule{}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["days_after_initiation"].(int); ok && v > 0 {
+				apiObject.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+					DaysAfterInitiation: aws.Int64(int64(v)),
+				}
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Expiration = expandBucketLifecycleConfigurationExpiration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			// An empty filter block applies the rule to all objects.
+			if v[0] == nil {
+				apiObject.Filter = &s3.LifecycleRuleFilter{
+					Prefix: aws.String(""),
+				}
+			} else {
+				apiObject.Filter = expandBucketLifecycleConfigurationFilter(v[0].(map[string]interface{}))
+			}
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			expiration := &s3.NoncurrentVersionExpiration{}
+
+			if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+				expiration.NewerNoncurrentVersions = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["noncurrent_days"].(int); ok && v > 0 {
+				expiration.NoncurrentDays = aws.Int64(int64(v))
+			}
+
+			apiObject.NoncurrentVersionExpiration = expiration
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				transition := &s3.NoncurrentVersionTransition{}
+
+				if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+					transition.NewerNoncurrentVersions = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["noncurrent_days"].(int); ok {
+					transition.NoncurrentDays = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+					transition.StorageClass = aws.String(v)
+				}
+
+				apiObject.NoncurrentVersionTransitions = append(apiObject.NoncurrentVersionTransitions, transition)
+			}
+		}
+
+		// The deprecated top-level prefix is only sent when no filter is configured.
+		if v, ok := tfMap["prefix"].(string); ok && v != "" && apiObject.Filter == nil {
+			apiObject.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				transition := &s3.Transition{}
+
+				if v, ok := tfMap["date"].(string); ok && v != "" {
+					t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+					transition.Date = aws.Time(t)
+				} else if v, ok := tfMap["days"].(int); ok {
+					transition.Days = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+					transition.StorageClass = aws.String(v)
+				}
+
+				apiObject.Transitions = append(apiObject.Transitions, transition)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBucketLifecycleConfigurationExpiration(tfMap map[string]interface{}) *s3.LifecycleExpiration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.LifecycleExpiration{}
+
+	if v, ok := tfMap["date"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+		apiObject.Date = aws.Time(t)
+	} else if v, ok := tfMap["days"].(int); ok && v > 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	} else if v, ok := tfMap["expired_object_delete_marker"].(bool); ok && v {
+		apiObject.ExpiredObjectDeleteMarker = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandBucketLifecycleConfigurationFilter(tfMap map[string]interface{}) *s3.LifecycleRuleFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.LifecycleRuleFilter{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		and := &s3.LifecycleRuleAndOperator{}
+
+		if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+			and.ObjectSizeGreaterThan = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+			and.ObjectSizeLessThan = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			and.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			and.Tags = Tags(tftags.New(v).IgnoreAWS())
+		}
+
+		apiObject.And = and
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tag = &s3.Tag{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+
+		return apiObject
+	}
+
+	// Only a single condition may be specified outside of an "and" block.
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThan = aws.Int64(int64(v))
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThan = aws.Int64(int64(v))
+
+		return apiObject
+	}
+
+	apiObject.Prefix = aws.String(tfMap["prefix"].(string))
+
+	return apiObject
+}
+
+func flattenBucketLifecycleConfigurationRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":     aws.StringValue(apiObject.ID),
+			"prefix": aws.StringValue(apiObject.Prefix),
+			"status": aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfMap["abort_incomplete_multipart_upload"] = []interface{}{
+				map[string]interface{}{
+					"days_after_initiation": int(aws.Int64Value(v.DaysAfterInitiation)),
+				},
+			}
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			expiration := map[string]interface{}{
+				"days":                         int(aws.Int64Value(v.Days)),
+				"expired_object_delete_marker": aws.BoolValue(v.ExpiredObjectDeleteMarker),
+			}
+
+			if v.Date != nil {
+				expiration["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			tfMap["expiration"] = []interface{}{expiration}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = flattenBucketLifecycleConfigurationFilter(v)
+		}
+
+		if v := apiObject.NoncurrentVersionExpiration; v != nil {
+			tfMap["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"newer_noncurrent_versions": int(aws.Int64Value(v.NewerNoncurrentVersions)),
+					"noncurrent_days":           int(aws.Int64Value(v.NoncurrentDays)),
+				},
+			}
+		}
+
+		var noncurrentVersionTransitions []interface{}
+
+		for _, v := range apiObject.NoncurrentVersionTransitions {
+			if v == nil {
+				continue
+			}
+
+			noncurrentVersionTransitions = append(noncurrentVersionTransitions, map[string]interface{}{
+				"newer_noncurrent_versions": int(aws.Int64Value(v.NewerNoncurrentVersions)),
+				"noncurrent_days":           int(aws.Int64Value(v.NoncurrentDays)),
+				"storage_class":             aws.StringValue(v.StorageClass),
+			})
+		}
+
+		tfMap["noncurrent_version_transition"] = noncurrentVersionTransitions
+
+		var transitions []interface{}
+
+		for _, v := range apiObject.Transitions {
+			if v == nil {
+				continue
+			}
+
+			transition := map[string]interface{}{
+				"days":          int(aws.Int64Value(v.Days)),
+				"storage_class": aws.StringValue(v.StorageClass),
+			}
+
+			if v.Date != nil {
+				transition["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			transitions = append(transitions, transition)
+		}
+
+		tfMap["transition"] = transitions
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBucketLifecycleConfigurationFilter(apiObject *s3.LifecycleRuleFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"object_size_greater_than": int(aws.Int64Value(apiObject.ObjectSizeGreaterThan)),
+		"object_size_less_than":    int(aws.Int64Value(apiObject.ObjectSizeLessThan)),
+		"prefix":                   aws.StringValue(apiObject.Prefix),
+	}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{
+			map[string]interface{}{
+				"object_size_greater_than": int(aws.Int64Value(v.ObjectSizeGreaterThan)),
+				"object_size_less_than":    int(aws.Int64Value(v.ObjectSizeLessThan)),
+				"prefix":                   aws.StringValue(v.Prefix),
+				"tags":                     KeyValueTags(v.Tags).IgnoreAWS().Map(),
+			},
+		}
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
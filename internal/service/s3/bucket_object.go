@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -156,8 +157,9 @@ func ResourceBucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption.
+				// The Etag then won't match raw-file MD5.
+				// Multipart upload ETags are handled in resourceBucketObjectRead.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -183,6 +185,18 @@ func ResourceBucketObject() *schema.Resource {
 				Default:  false,
 			},
 
+			"multipart_upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"multipart_upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -251,7 +265,7 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
@@ -320,7 +334,19 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := conn.PutObject(putInput); err != nil {
+	// The upload manager streams the source in parts, switching to a multipart
+	// upload when the body is larger than the part size.
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("multipart_upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("multipart_upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
+
+	if _, err := uploader.Upload(putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -408,7 +434,13 @@ func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+
+	// The ETag of an object uploaded in multiple parts is not the MD5 digest of its content,
+	// so a configured etag (e.g. from filemd5()) is kept and source_hash should be used to trigger updates.
+	if !isMultipartUploadETag(etag) || d.Get("etag").(string) == "" {
+		d.Set("etag", etag)
+	}
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -604,6 +636,11 @@ func resourceBucketObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// isMultipartUploadETag returns whether an ETag has the "<hex digest>-<part count>" form of a multipart upload.
+func isMultipartUploadETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
+
 func hasS3BucketObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
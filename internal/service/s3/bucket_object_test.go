@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -111,6 +112,41 @@ func TestAccS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_multipartUpload(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// Larger than two 5 MiB parts.
+	source := testAccBucketObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 12*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectMultipartUploadConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectExists(resourceName, &obj),
+					func(s *terraform.State) error {
+						if etag := aws.StringValue(obj.ETag); !strings.Contains(etag, "-") {
+							return fmt.Errorf("expected multipart upload ETag, got %s", etag)
+						}
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_part_size", "5242880"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketObject_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1597,6 +1633,24 @@ resource "aws_s3_bucket_object" "object" {
 `, rName, source)
 }
 
+func testAccBucketObjectMultipartUploadConfig(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+  etag   = filemd5(%[2]q)
+
+  multipart_upload_concurrency = 2
+  multipart_upload_part_size   = 5242880
+}
+`, rName, source)
+}
+
 func testAccBucketObjectConfig_withContentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -564,10 +564,11 @@ func TestAccS3Bucket_Security_updateGrant(t *testing.T) {
 				),
 			},
 			{
+				// grant is computed, so removing the inline block leaves the bucket ACL unchanged.
 				Config: testAccBucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
 				),
 			},
 		},
@@ -1,6 +1,7 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -24,7 +25,7 @@ func ResourceAccessPoint() *schema.Resource {
 		Delete: resourceAccessPointDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceAccessPointImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -360,6 +361,21 @@ func resourceAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAccessPointImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Access Points in an AWS Partition S3 Bucket can also be imported by ARN.
+	if arn.IsARN(d.Id()) {
+		resourceID, err := AccessPointCreateResourceID(d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(resourceID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 const accessPointResourceIDSeparator = ":"
 
 func AccessPointCreateResourceID(accessPointARN string) (string, error) {
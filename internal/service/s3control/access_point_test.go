@@ -84,6 +84,41 @@ func TestAccS3ControlAccessPoint_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlAccessPoint_s3controlResourceName(t *testing.T) {
+	var v s3control.GetAccessPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_s3controlResourceName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "s3", fmt.Sprintf("accesspoint/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAccessPointImportStateIdFuncARN(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3ControlAccessPoint_Bucket_arn(t *testing.T) {
 	var v s3control.GetAccessPointOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -303,7 +338,7 @@ func testAccCheckAccessPointDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_s3_access_point" {
+		if rs.Type != "aws_s3_access_point" && rs.Type != "aws_s3control_access_point" {
 			continue
 		}
 
@@ -359,6 +394,17 @@ func testAccCheckAccessPointExists(n string, v *s3control.GetAccessPointOutput)
 	}
 }
 
+func testAccAccessPointImportStateIdFuncARN(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
+
 func testAccCheckAccessPointHasPolicy(n string, fn func() string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -412,6 +458,19 @@ resource "aws_s3_access_point" "test" {
 `, bucketName, accessPointName)
 }
 
+func testAccAccessPointConfig_s3controlResourceName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3control_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+}
+`, rName)
+}
+
 func testAccAccessPointConfig_Bucket_ARN(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
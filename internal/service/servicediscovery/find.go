@@ -34,6 +34,40 @@ func FindInstanceByServiceIDAndInstanceID(conn *servicediscovery.ServiceDiscover
 	return output.Instance, nil
 }
 
+func FindInstanceHealthStatusByServiceIDAndInstanceID(conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string) (string, error) {
+	input := &servicediscovery.GetInstancesHealthStatusInput{
+		Instances: aws.StringSlice([]string{instanceID}),
+		ServiceId: aws.String(serviceID),
+	}
+
+	output, err := conn.GetInstancesHealthStatus(input)
+
+	if tfawserr.ErrCodeEquals(err, servicediscovery.ErrCodeInstanceNotFound, servicediscovery.ErrCodeServiceNotFound) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	status, ok := output.Status[instanceID]
+
+	if !ok || status == nil {
+		return "", &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return aws.StringValue(status), nil
+}
+
 func FindOperationByID(conn *servicediscovery.ServiceDiscovery, id string) (*servicediscovery.Operation, error) {
 	input := &servicediscovery.GetOperationInput{
 		OperationId: aws.String(id),
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -42,6 +43,10 @@ func ResourceInstance() *schema.Resource {
 					validation.MapValueMatch(regexp.MustCompile(`^([a-zA-Z0-9!-~][ \ta-zA-Z0-9!-~]*){0,1}[a-zA-Z0-9!-~]{0,1}$`), ""),
 				),
 			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -115,6 +120,20 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("attributes", aws.StringValueMap(attributes))
 	d.Set("instance_id", instance.Id)
 
+	healthStatus, err := FindInstanceHealthStatusByServiceIDAndInstanceID(conn, d.Get("service_id").(string), d.Get("instance_id").(string))
+
+	// Services without a health check configuration reject the request and newly
+	// registered instances may not yet have a status; report both as UNKNOWN.
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, servicediscovery.ErrCodeInvalidInput) {
+		healthStatus, err = servicediscovery.HealthStatusUnknown, nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Discovery Instance (%s) health status: %w", d.Id(), err)
+	}
+
+	d.Set("health_status", healthStatus)
+
 	return nil
 }
 
@@ -39,6 +39,7 @@ func TestAccServiceDiscoveryInstance_private(t *testing.T) {
 					testAccCheckInstanceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.1"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV6", "2001:0db8:85a3:0000:0000:abcd:0001:2345"),
 				),
@@ -53,6 +54,7 @@ func TestAccServiceDiscoveryInstance_private(t *testing.T) {
 					testAccCheckInstanceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.2"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV6", "2001:0db8:85a3:0000:0000:abcd:0001:2345"),
 				),
@@ -92,6 +94,7 @@ func TestAccServiceDiscoveryInstance_public(t *testing.T) {
 					testAccCheckInstanceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "52.18.0.2"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.CUSTOM_KEY", "this is a custom value"),
 				),
@@ -106,6 +109,7 @@ func TestAccServiceDiscoveryInstance_public(t *testing.T) {
 					testAccCheckInstanceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "52.18.0.2"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.CUSTOM_KEY", "this is a custom value updated"),
 				),
@@ -145,6 +149,7 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 					testAccCheckInstanceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
 					resource.TestCheckResourceAttrSet(resourceName, "attributes.AWS_EC2_INSTANCE_ID"),
 				),
 			},
@@ -158,6 +163,7 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 					testAccCheckInstanceExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "172.18.0.12"),
 				),
 			},
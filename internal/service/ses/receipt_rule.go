@@ -406,6 +406,18 @@ func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*sch
 	ruleSetName := idParts[0]
 	ruleName := idParts[1]
 
+	conn := meta.(*conns.AWSClient).SESConn
+
+	before, err := findReceiptRuleNamesBefore(conn, ruleSetName, ruleName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading SES Receipt Rule (%s) position: %w", ruleName, err)
+	}
+
+	if len(before) > 0 {
+		d.Set("after", before[len(before)-1])
+	}
+
 	d.Set("rule_set_name", ruleSetName)
 	d.Set("name", ruleName)
 	d.SetId(ruleName)
@@ -416,6 +428,10 @@ func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*sch
 func resourceReceiptRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
 
+	mutexKey := receiptRuleSetMutexKey(d.Get("rule_set_name").(string))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	createOpts := &ses.CreateReceiptRuleInput{
 		Rule:        buildReceiptRule(d),
 		RuleSetName: aws.String(d.Get("rule_set_name").(string)),
@@ -438,6 +454,10 @@ func resourceReceiptRuleCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceReceiptRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
 
+	mutexKey := receiptRuleSetMutexKey(d.Get("rule_set_name").(string))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	updateOpts := &ses.UpdateReceiptRuleInput{
 		Rule:        buildReceiptRule(d),
 		RuleSetName: aws.String(d.Get("rule_set_name").(string)),
@@ -450,11 +470,15 @@ func resourceReceiptRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("after") {
 		changePosOpts := &ses.SetReceiptRulePositionInput{
-			After:       aws.String(d.Get("after").(string)),
 			RuleName:    aws.String(d.Get("name").(string)),
 			RuleSetName: aws.String(d.Get("rule_set_name").(string)),
 		}
 
+		// Without after, the rule is moved to the first position.
+		if v, ok := d.GetOk("after"); ok {
+			changePosOpts.After = aws.String(v.(string))
+		}
+
 		_, err := conn.SetReceiptRulePosition(changePosOpts)
 		if err != nil {
 			return fmt.Errorf("Error updating SES rule: %s", err)
@@ -488,6 +512,28 @@ func resourceReceiptRuleRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	before, err := findReceiptRuleNamesBefore(conn, ruleSetName, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading SES Receipt Rule (%s) position: %w", d.Id(), err)
+	}
+
+	// The position only drifts once the configured rule no longer precedes this one,
+	// so rules sharing the same after do not swap places on every apply.
+	// Without after, the position is not managed.
+	if after := d.Get("after").(string); after != "" {
+		var predecessor string
+
+		for _, name := range before {
+			predecessor = name
+
+			if name == after {
+				break
+			}
+		}
+
+		d.Set("after", predecessor)
+	}
 	d.Set("enabled", response.Rule.Enabled)
 	d.Set("recipients", flex.FlattenStringSet(response.Rule.Recipients))
 	d.Set("scan_enabled", response.Rule.ScanEnabled)
@@ -656,6 +702,10 @@ func resourceReceiptRuleRead(d *schema.ResourceData, meta interface{}) error {
 func resourceReceiptRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
 
+	mutexKey := receiptRuleSetMutexKey(d.Get("rule_set_name").(string))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	deleteOpts := &ses.DeleteReceiptRuleInput{
 		RuleName:    aws.String(d.Id()),
 		RuleSetName: aws.String(d.Get("rule_set_name").(string)),
@@ -669,6 +719,43 @@ func resourceReceiptRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// receiptRuleSetMutexKey serializes changes to the rules of a rule set. SES
+// positions rules relative to each other, so concurrent creates and moves
+// within the same rule set can otherwise produce a non-deterministic order.
+func receiptRuleSetMutexKey(ruleSetName string) string {
+	return fmt.Sprintf("ses-receipt-rule-set-%s", ruleSetName)
+}
+
+// findReceiptRuleNamesBefore returns the names of the rules placed before the
+// specified rule in its rule set, in rule set order.
+func findReceiptRuleNamesBefore(conn *ses.SES, ruleSetName, ruleName string) ([]string, error) {
+	output, err := conn.DescribeReceiptRuleSet(&ses.DescribeReceiptRuleSetInput{
+		RuleSetName: aws.String(ruleSetName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, rule := range output.Rules {
+		if rule == nil {
+			continue
+		}
+
+		name := aws.StringValue(rule.Name)
+
+		if name == ruleName {
+			break
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
 func buildReceiptRule(d *schema.ResourceData) *ses.ReceiptRule {
 	receiptRule := &ses.ReceiptRule{
 		Name: aws.String(d.Get("name").(string)),
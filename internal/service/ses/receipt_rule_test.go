@@ -2,6 +2,7 @@ package ses_test
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -274,6 +275,55 @@ func TestAccSESReceiptRule_order(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateIdFunc: testAccReceiptRuleImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReceiptRuleOrderUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "name", "second"),
+					resource.TestCheckResourceAttrPair(resourceName, "after", "aws_ses_receipt_rule.test2", "name"),
+					resource.TestCheckResourceAttrPair("aws_ses_receipt_rule.test2", "after", "aws_ses_receipt_rule.test1", "name"),
+					testAccCheckReceiptRuleSetOrder(resourceName, "first", "middle", "second"),
+				),
+			},
+			{
+				Config: testAccReceiptRuleOrderFirstConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "after", ""),
+					testAccCheckReceiptRuleSetOrder(resourceName, "second", "first", "middle"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESReceiptRule_orderSameAfter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			testAccPreCheckSESReceiptRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSESReceiptRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Both rules only need to follow "first", so neither is moved on subsequent plans.
+				Config: testAccReceiptRuleOrderSameAfterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "after", "aws_ses_receipt_rule.test1", "name"),
+					resource.TestCheckResourceAttrPair("aws_ses_receipt_rule.test2", "after", "aws_ses_receipt_rule.test1", "name"),
+				),
+			},
+			{
+				Config:   testAccReceiptRuleOrderSameAfterConfig(rName),
+				PlanOnly: true,
 			},
 		},
 	})
@@ -417,6 +467,37 @@ func testAccCheckReceiptRuleExists(n string, rule *ses.ReceiptRule) resource.Tes
 	}
 }
 
+func testAccCheckReceiptRuleSetOrder(n string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("SES Receipt Rule not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+
+		output, err := conn.DescribeReceiptRuleSet(&ses.DescribeReceiptRuleSetInput{
+			RuleSetName: aws.String(rs.Primary.Attributes["rule_set_name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+
+		for _, rule := range output.Rules {
+			got = append(got, aws.StringValue(rule.Name))
+		}
+
+		if !reflect.DeepEqual(got, names) {
+			return fmt.Errorf("SES Receipt Rule Set rules are %v, expected %v", got, names)
+		}
+
+		return nil
+	}
+}
+
 func testAccReceiptRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -652,6 +733,80 @@ resource "aws_ses_receipt_rule" "test1" {
 `, rName)
 }
 
+func testAccReceiptRuleOrderUpdatedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = "second"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  after         = aws_ses_receipt_rule.test2.name
+}
+
+resource "aws_ses_receipt_rule" "test1" {
+  name          = "first"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "test2" {
+  name          = "middle"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  after         = aws_ses_receipt_rule.test1.name
+}
+`, rName)
+}
+
+func testAccReceiptRuleOrderFirstConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = "second"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "test1" {
+  name          = "first"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "test2" {
+  name          = "middle"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  after         = aws_ses_receipt_rule.test1.name
+}
+`, rName)
+}
+
+func testAccReceiptRuleOrderSameAfterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = "second"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  after         = aws_ses_receipt_rule.test1.name
+}
+
+resource "aws_ses_receipt_rule" "test1" {
+  name          = "first"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "test2" {
+  name          = "third"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  after         = aws_ses_receipt_rule.test1.name
+}
+`, rName)
+}
+
 func testAccReceiptRuleActionsConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_rule_set" "test" {
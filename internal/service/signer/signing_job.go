@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
@@ -17,6 +18,7 @@ func ResourceSigningJob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSigningJobCreate,
 		Read:   resourceSigningJobRead,
+		Update: resourceSigningJobUpdate,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -99,6 +101,11 @@ func ResourceSigningJob() *schema.Resource {
 				ForceNew: true,
 				Default:  false,
 			},
+			"revocation_reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
 			"completed_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -228,6 +235,12 @@ func resourceSigningJobCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(jobId)
 
+	if v, ok := d.GetOk("revocation_reason"); ok {
+		if err := revokeSigningJobSignature(conn, d.Id(), "", v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceSigningJobRead(d, meta)
 }
 
@@ -320,6 +333,43 @@ func resourceSigningJobRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceSigningJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SignerConn
+
+	if d.HasChange("revocation_reason") {
+		// A revoked signature cannot be restored, so only a non-empty reason results in an API call.
+		if v, ok := d.GetOk("revocation_reason"); ok && len(d.Get("revocation_record").([]interface{})) == 0 {
+			if err := revokeSigningJobSignature(conn, d.Id(), d.Get("job_owner").(string), v.(string)); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[WARN] Signer Signing Job (%s) signature revocation cannot be changed", d.Id())
+		}
+	}
+
+	return resourceSigningJobRead(d, meta)
+}
+
+func revokeSigningJobSignature(conn *signer.Signer, jobID, jobOwner, reason string) error {
+	input := &signer.RevokeSignatureInput{
+		JobId:  aws.String(jobID),
+		Reason: aws.String(reason),
+	}
+
+	if jobOwner != "" {
+		input.JobOwner = aws.String(jobOwner)
+	}
+
+	log.Printf("[DEBUG] Revoking Signer Signing Job signature: %s", input)
+	_, err := conn.RevokeSignature(input)
+
+	if err != nil {
+		return fmt.Errorf("error revoking Signer Signing Job (%s) signature: %w", jobID, err)
+	}
+
+	return nil
+}
+
 func flattenSignerSigningJobRevocationRecord(apiObject *signer.SigningJobRevocationRecord) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -42,7 +42,84 @@ func TestAccSignerSigningJob_basic(t *testing.T) {
 
 }
 
+func TestAccSignerSigningJob_revocationReason(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_signer_signing_job.test"
+
+	var job signer.DescribeSigningJobOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
+		ErrorCheck:   acctest.ErrorCheck(t, signer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningJobConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "0"),
+				),
+			},
+			{
+				Config: testAccSigningJobRevocationReasonConfig(rName, "testing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "revocation_reason", "testing"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.0.reason", "testing"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSigningJobConfig(rName string) string {
+	return testAccSigningJobBaseConfig(rName) + `
+resource "aws_signer_signing_job" "test" {
+  profile_name = aws_signer_signing_profile.test.name
+
+  source {
+    s3 {
+      bucket  = aws_s3_bucket_object.source.bucket
+      key     = aws_s3_bucket_object.source.key
+      version = aws_s3_bucket_object.source.version_id
+    }
+  }
+
+  destination {
+    s3 {
+      bucket = aws_s3_bucket.destination.bucket
+    }
+  }
+}
+`
+}
+
+func testAccSigningJobRevocationReasonConfig(rName, reason string) string {
+	return testAccSigningJobBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_signer_signing_job" "test" {
+  profile_name      = aws_signer_signing_profile.test.name
+  revocation_reason = %[1]q
+
+  source {
+    s3 {
+      bucket  = aws_s3_bucket_object.source.bucket
+      key     = aws_s3_bucket_object.source.key
+      version = aws_s3_bucket_object.source.version_id
+    }
+  }
+
+  destination {
+    s3 {
+      bucket = aws_s3_bucket.destination.bucket
+    }
+  }
+}
+`, reason)
+}
+
+func testAccSigningJobBaseConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
 
@@ -70,24 +147,6 @@ resource "aws_s3_bucket_object" "source" {
   key    = "lambdatest.zip"
   source = "test-fixtures/lambdatest.zip"
 }
-
-resource "aws_signer_signing_job" "test" {
-  profile_name = aws_signer_signing_profile.test.name
-
-  source {
-    s3 {
-      bucket  = aws_s3_bucket_object.source.bucket
-      key     = aws_s3_bucket_object.source.key
-      version = aws_s3_bucket_object.source.version_id
-    }
-  }
-
-  destination {
-    s3 {
-      bucket = aws_s3_bucket.destination.bucket
-    }
-  }
-}
 `, rName)
 }
 
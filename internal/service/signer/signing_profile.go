@@ -75,6 +75,25 @@ func ResourceSigningProfile() *schema.Resource {
 					},
 				},
 			},
+			"revoke": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effective_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"reason": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 500),
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"arn": {
@@ -157,6 +176,20 @@ func resourceSigningProfileCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(profileName)
 
+	if v, ok := d.GetOk("revoke"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		output, err := conn.GetSigningProfile(&signer.GetSigningProfileInput{
+			ProfileName: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading Signer signing profile (%s): %w", d.Id(), err)
+		}
+
+		if err := revokeSigningProfile(conn, d.Id(), aws.StringValue(output.ProfileVersion), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceSigningProfileRead(d, meta)
 }
 
@@ -238,6 +271,20 @@ func resourceSigningProfileUpdate(d *schema.ResourceData, meta interface{}) erro
 	conn := meta.(*conns.AWSClient).SignerConn
 
 	arn := d.Get("arn").(string)
+
+	if d.HasChange("revoke") {
+		// Revocation cannot be undone, so only the addition of a revoke block results in an API call.
+		if v, ok := d.GetOk("revoke"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if status := d.Get("status").(string); status == signer.SigningProfileStatusRevoked {
+				log.Printf("[WARN] Signer signing profile (%s) is already revoked", d.Id())
+			} else if err := revokeSigningProfile(conn, d.Id(), d.Get("version").(string), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[WARN] Signer signing profile (%s) revocation cannot be undone", d.Id())
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -288,3 +335,23 @@ func flattenSignerSigningProfileRevocationRecord(apiObject *signer.SigningProfil
 
 	return []interface{}{tfMap}
 }
+
+func revokeSigningProfile(conn *signer.Signer, profileName, profileVersion string, tfMap map[string]interface{}) error {
+	effectiveTime, _ := time.Parse(time.RFC3339, tfMap["effective_time"].(string))
+
+	input := &signer.RevokeSigningProfileInput{
+		EffectiveTime:  aws.Time(effectiveTime),
+		ProfileName:    aws.String(profileName),
+		ProfileVersion: aws.String(profileVersion),
+		Reason:         aws.String(tfMap["reason"].(string)),
+	}
+
+	log.Printf("[DEBUG] Revoking Signer signing profile: %s", input)
+	_, err := conn.RevokeSigningProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error revoking Signer signing profile (%s): %w", profileName, err)
+	}
+
+	return nil
+}
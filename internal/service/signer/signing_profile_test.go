@@ -155,6 +155,40 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningProfile_revoke(t *testing.T) {
+	resourceName := "aws_signer_signing_profile.test_sp"
+	namePrefix := "tf_acc_sp_revoke_"
+	effectiveTime := time.Now().UTC().Format(time.RFC3339)
+
+	var conf signer.GetSigningProfileOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
+		ErrorCheck:   acctest.ErrorCheck(t, signer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSigningProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileConfig(namePrefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "status", signer.SigningProfileStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "0"),
+				),
+			},
+			{
+				Config: testAccSigningProfileRevokeConfig(namePrefix, effectiveTime, "testing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "status", signer.SigningProfileStatusRevoked),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_record.0.revoked_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
 
@@ -265,6 +299,20 @@ resource "aws_signer_signing_profile" "test_sp" {
 `, namePrefix)
 }
 
+func testAccSigningProfileRevokeConfig(namePrefix, effectiveTime, reason string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name_prefix = %[1]q
+
+  revoke {
+    effective_time = %[2]q
+    reason         = %[3]q
+  }
+}
+`, namePrefix, effectiveTime, reason)
+}
+
 func testAccCheckSigningProfileExists(res string, sp *signer.GetSigningProfileOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[res]
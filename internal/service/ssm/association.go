@@ -1,6 +1,7 @@
 package ssm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,9 +9,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 func ResourceAssociation() *schema.Resource {
@@ -27,6 +30,11 @@ func ResourceAssociation() *schema.Resource {
 		MigrateState:  AssociationMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: customdiff.ForceNewIfChange("target_locations", func(_ context.Context, old, new, meta interface{}) bool {
+			// UpdateAssociation cannot remove all target locations.
+			return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"apply_only_at_cron_interval": {
 				Type:     schema.TypeBool,
@@ -132,6 +140,58 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"last_execution_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sync_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.AssociationSyncCompliance_Values(), false),
+			},
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -193,6 +253,18 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	resp, err := conn.CreateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error creating SSM association: %s", err)
@@ -242,6 +314,8 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("max_concurrency", association.MaxConcurrency)
 	d.Set("max_errors", association.MaxErrors)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
+	d.Set("sync_compliance", association.SyncCompliance)
 
 	if err := d.Set("parameters", flattenParameters(association.Parameters)); err != nil {
 		return err
@@ -255,6 +329,22 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting output_location error: %#v", err)
 	}
 
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return fmt.Errorf("error setting target_locations: %w", err)
+	}
+
+	execution, err := FindAssociationLatestExecution(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Association (%s) executions: %w", d.Id(), err)
+	}
+
+	if execution != nil {
+		d.Set("last_execution_status", execution.Status)
+	} else {
+		d.Set("last_execution_status", nil)
+	}
+
 	return nil
 }
 
@@ -312,6 +402,22 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	// Calendar names are removed by passing an empty list.
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	} else if d.HasChange("calendar_names") {
+		associationInput.CalendarNames = []*string{}
+	}
+
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	// Removing all target locations forces a new resource.
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	_, err := conn.UpdateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error updating SSM association: %s", err)
@@ -523,6 +523,112 @@ func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_syncCompliance(t *testing.T) {
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationSyncComplianceConfig(rName, ssm.AssociationSyncComplianceManual),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceManual),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationSyncComplianceConfig(rName, ssm.AssociationSyncComplianceAuto),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceAuto),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_calendarNames(t *testing.T) {
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationCalendarNamesConfig(rName, "[aws_ssm_document.calendar.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationCalendarNamesConfig(rName, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetLocations(t *testing.T) {
+	var associationID string
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationTargetLocationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					testAccCheckAssociationID(resourceName, &associationID),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing all target locations recreates the association.
+				Config: testAccAssociationTargetLocationsRemovedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					testAccCheckAssociationRecreated(resourceName, &associationID),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_rateControl(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
@@ -562,6 +668,34 @@ func TestAccSSMAssociation_rateControl(t *testing.T) {
 	})
 }
 
+func testAccCheckAssociationID(n string, associationID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*associationID = rs.Primary.Attributes["association_id"]
+
+		return nil
+	}
+}
+
+func testAccCheckAssociationRecreated(n string, associationID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["association_id"] == *associationID {
+			return fmt.Errorf("SSM Association (%s) was not recreated", *associationID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAssociationExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1534,3 +1668,137 @@ resource "aws_ssm_association" "test" {
 }
 `, rName, rate, rate)
 }
+
+func testAccAssociationSyncComplianceConfig(rName, syncCompliance string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = "tf-test-ssm-document-%[1]s"
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name            = aws_ssm_document.test.name
+  sync_compliance = %[2]q
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, syncCompliance)
+}
+
+func testAccAssociationCalendarNamesConfig(rName, calendarNames string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "tf-test-ssm-calendar-%[1]s"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+DOC
+}
+
+resource "aws_ssm_document" "test" {
+  name          = "test_document_association-%[1]s"
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name           = aws_ssm_document.test.name
+  calendar_names = %[2]s
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, calendarNames)
+}
+
+func testAccAssociationTargetLocationsConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_ssm_association" "test" {
+  association_name                 = %[1]q
+  name                             = "AWS-StopEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+
+  target_locations {
+    accounts = [data.aws_caller_identity.current.account_id]
+    regions  = [data.aws_region.current.name]
+  }
+}
+`, rName)
+}
+
+func testAccAssociationTargetLocationsRemovedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  association_name                 = %[1]q
+  name                             = "AWS-StopEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName)
+}
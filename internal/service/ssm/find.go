@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindDocumentByName returns the Document corresponding to the specified name.
@@ -59,3 +62,93 @@ func FindPatchGroup(conn *ssm.SSM, patchGroup, baselineId string) (*ssm.PatchGro
 
 	return result, err
 }
+
+// FindAssociationLatestExecution returns the most recently created execution of the specified association.
+// Executions are returned newest first, so only the first result is read.
+// Returns nil if the association has not been executed.
+func FindAssociationLatestExecution(conn *ssm.SSM, associationID string) (*ssm.AssociationExecution, error) {
+	input := &ssm.DescribeAssociationExecutionsInput{
+		AssociationId: aws.String(associationID),
+		MaxResults:    aws.Int64(1),
+	}
+
+	output, err := conn.DescribeAssociationExecutions(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AssociationExecutions) == 0 {
+		return nil, nil
+	}
+
+	return output.AssociationExecutions[0], nil
+}
+
+// FindOpsItemByID returns the OpsItem corresponding to the specified ID.
+func FindOpsItemByID(conn *ssm.SSM, id string) (*ssm.OpsItem, error) {
+	input := &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	}
+
+	output, err := conn.GetOpsItem(input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OpsItem == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OpsItem, nil
+}
+
+// FindOpsMetadataByARN returns the OpsMetadata resource ID and all of its metadata for the specified ARN.
+func FindOpsMetadataByARN(conn *ssm.SSM, arn string) (string, map[string]*ssm.MetadataValue, error) {
+	input := &ssm.GetOpsMetadataInput{
+		OpsMetadataArn: aws.String(arn),
+	}
+	var resourceID string
+	metadata := make(map[string]*ssm.MetadataValue)
+
+	for {
+		output, err := conn.GetOpsMetadata(input)
+
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+			return "", nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		if output == nil {
+			return "", nil, tfresource.NewEmptyResultError(input)
+		}
+
+		resourceID = aws.StringValue(output.ResourceId)
+
+		for k, v := range output.Metadata {
+			metadata[k] = v
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return resourceID, metadata, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
@@ -55,3 +56,59 @@ func flattenTargets(targets []*ssm.Target) []map[string]interface{} {
 
 	return result
 }
+
+func expandTargetLocations(in []interface{}) []*ssm.TargetLocation {
+	targetLocations := make([]*ssm.TargetLocation, 0)
+
+	for _, tConfig := range in {
+		config, ok := tConfig.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		targetLocation := &ssm.TargetLocation{
+			Accounts: flex.ExpandStringSet(config["accounts"].(*schema.Set)),
+			Regions:  flex.ExpandStringSet(config["regions"].(*schema.Set)),
+		}
+
+		if v, ok := config["execution_role_name"].(string); ok && v != "" {
+			targetLocation.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := config["target_location_max_concurrency"].(string); ok && v != "" {
+			targetLocation.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := config["target_location_max_errors"].(string); ok && v != "" {
+			targetLocation.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		targetLocations = append(targetLocations, targetLocation)
+	}
+
+	return targetLocations
+}
+
+func flattenTargetLocations(targetLocations []*ssm.TargetLocation) []interface{} {
+	if len(targetLocations) == 0 {
+		return nil
+	}
+
+	result := make([]interface{}, 0, len(targetLocations))
+	for _, targetLocation := range targetLocations {
+		if targetLocation == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(targetLocation.Accounts),
+			"execution_role_name":             aws.StringValue(targetLocation.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(targetLocation.Regions),
+			"target_location_max_concurrency": aws.StringValue(targetLocation.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(targetLocation.TargetLocationMaxErrors),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,470 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOpsItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOpsItemCreate,
+		Read:   resourceOpsItemRead,
+		Update: resourceOpsItemUpdate,
+		Delete: resourceOpsItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"operational_data": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.OpsItemDataTypeSearchableString,
+							ValidateFunc: validation.StringInSlice(ssm.OpsItemDataType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ops_item_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"related_ops_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.OpsItemStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsItemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	title := d.Get("title").(string)
+	input := &ssm.CreateOpsItemInput{
+		Description: aws.String(d.Get("description").(string)),
+		Source:      aws.String(d.Get("source").(string)),
+		Title:       aws.String(title),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.Notifications = expandOpsItemNotifications(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("operational_data"); ok && v.(*schema.Set).Len() > 0 {
+		input.OperationalData = expandOpsItemOperationalData(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("ops_item_type"); ok {
+		input.OpsItemType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("related_ops_item_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.RelatedOpsItems = expandRelatedOpsItems(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM OpsItem: %s", input)
+	output, err := conn.CreateOpsItem(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSM OpsItem (%s): %w", title, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsItemId))
+
+	// OpsItems are always created in the Open status.
+	if v, ok := d.GetOk("status"); ok && v.(string) != ssm.OpsItemStatusOpen {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+			Status:    aws.String(v.(string)),
+		}
+
+		log.Printf("[DEBUG] Updating SSM OpsItem: %s", input)
+		if _, err := conn.UpdateOpsItem(input); err != nil {
+			return fmt.Errorf("error updating SSM OpsItem (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceOpsItemRead(d, meta)
+}
+
+func resourceOpsItemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	opsItem, err := FindOpsItemByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsItem (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM OpsItem (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ssm",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("opsitem/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("category", opsItem.Category)
+	d.Set("created_by", opsItem.CreatedBy)
+	if opsItem.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(opsItem.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", opsItem.Description)
+	if opsItem.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(opsItem.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+
+	if err := d.Set("notification_arns", flattenOpsItemNotifications(opsItem.Notifications)); err != nil {
+		return fmt.Errorf("error setting notification_arns: %w", err)
+	}
+
+	if err := d.Set("operational_data", flattenOpsItemOperationalData(opsItem.OperationalData)); err != nil {
+		return fmt.Errorf("error setting operational_data: %w", err)
+	}
+
+	d.Set("ops_item_type", opsItem.OpsItemType)
+	d.Set("priority", opsItem.Priority)
+
+	if err := d.Set("related_ops_item_ids", flattenRelatedOpsItems(opsItem.RelatedOpsItems)); err != nil {
+		return fmt.Errorf("error setting related_ops_item_ids: %w", err)
+	}
+
+	d.Set("severity", opsItem.Severity)
+	d.Set("source", opsItem.Source)
+	d.Set("status", opsItem.Status)
+	d.Set("title", opsItem.Title)
+
+	tags, err := ListTags(conn, d.Id(), ssm.ResourceTypeForTaggingOpsItem)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SSM OpsItem (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOpsItemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("category") {
+			input.Category = aws.String(d.Get("category").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("notification_arns") {
+			input.Notifications = expandOpsItemNotifications(d.Get("notification_arns").(*schema.Set).List())
+		}
+
+		if d.HasChange("operational_data") {
+			o, n := d.GetChange("operational_data")
+			oldData := expandOpsItemOperationalData(o.(*schema.Set).List())
+			newData := expandOpsItemOperationalData(n.(*schema.Set).List())
+
+			for k := range oldData {
+				if _, ok := newData[k]; !ok {
+					input.OperationalDataToDelete = append(input.OperationalDataToDelete, aws.String(k))
+				}
+			}
+
+			if len(newData) > 0 {
+				input.OperationalData = newData
+			}
+		}
+
+		if d.HasChange("priority") {
+			if v, ok := d.GetOk("priority"); ok {
+				input.Priority = aws.Int64(int64(v.(int)))
+			}
+		}
+
+		if d.HasChange("related_ops_item_ids") {
+			input.RelatedOpsItems = expandRelatedOpsItems(d.Get("related_ops_item_ids").(*schema.Set).List())
+		}
+
+		if d.HasChange("severity") {
+			input.Severity = aws.String(d.Get("severity").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		log.Printf("[DEBUG] Updating SSM OpsItem: %s", input)
+		_, err := conn.UpdateOpsItem(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SSM OpsItem (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), ssm.ResourceTypeForTaggingOpsItem, o, n); err != nil {
+			return fmt.Errorf("error updating SSM OpsItem (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceOpsItemRead(d, meta)
+}
+
+func resourceOpsItemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	// OpsItems cannot be deleted, so they are resolved instead.
+	log.Printf("[DEBUG] Resolving SSM OpsItem: %s", d.Id())
+	_, err := conn.UpdateOpsItem(&ssm.UpdateOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+		Status:    aws.String(ssm.OpsItemStatusResolved),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resolving SSM OpsItem (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOpsItemNotifications(tfList []interface{}) []*ssm.OpsItemNotification {
+	apiObjects := make([]*ssm.OpsItemNotification, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringList(tfList) {
+		apiObjects = append(apiObjects, &ssm.OpsItemNotification{
+			Arn: v,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemNotifications(apiObjects []*ssm.OpsItemNotification) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Arn))
+	}
+
+	return tfList
+}
+
+func expandOpsItemOperationalData(tfList []interface{}) map[string]*ssm.OpsItemDataValue {
+	apiObjects := make(map[string]*ssm.OpsItemDataValue, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["key"].(string)] = &ssm.OpsItemDataValue{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemOperationalData(apiObjects map[string]*ssm.OpsItemDataValue) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandRelatedOpsItems(tfList []interface{}) []*ssm.RelatedOpsItem {
+	apiObjects := make([]*ssm.RelatedOpsItem, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringList(tfList) {
+		apiObjects = append(apiObjects, &ssm.RelatedOpsItem{
+			OpsItemId: v,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRelatedOpsItems(apiObjects []*ssm.RelatedOpsItem) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.OpsItemId))
+	}
+
+	return tfList
+}
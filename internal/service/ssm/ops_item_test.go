@@ -0,0 +1,267 @@
+package ssm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMOpsItem_basic(t *testing.T) {
+	var opsItem ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOpsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName, &opsItem),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexp.MustCompile(`opsitem/oi-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusOpen),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_update(t *testing.T) {
+	var opsItem ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOpsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemFullConfig(rName, "Availability", "2", 3, ssm.OpsItemStatusOpen, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "category", "Availability"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "/tf/key1",
+						"type":  ssm.OpsItemDataTypeSearchableString,
+						"value": "value1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "3"),
+					resource.TestCheckResourceAttr(resourceName, "related_ops_item_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "related_ops_item_ids.*", "aws_ssm_ops_item.related", "id"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusOpen),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemFullConfig(rName, "Performance", "3", 4, ssm.OpsItemStatusInProgress, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "category", "Performance"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "/tf/key1",
+						"type":  ssm.OpsItemDataTypeSearchableString,
+						"value": "value2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "4"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusInProgress),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_tags(t *testing.T) {
+	var opsItem ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOpsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemExists(n string, v *ssm.OpsItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsItem ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		output, err := tfssm.FindOpsItemByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// OpsItems cannot be deleted, so destruction is verified by checking that they have been resolved.
+func testAccCheckOpsItemDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_ops_item" {
+			continue
+		}
+
+		output, err := tfssm.FindOpsItemByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status != ssm.OpsItemStatusResolved {
+			return fmt.Errorf("SSM OpsItem %s still has status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccOpsItemConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test description"
+  source      = "terraform"
+}
+`, rName)
+}
+
+func testAccOpsItemFullConfig(rName, category, severity string, priority int, status, dataValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "related" {
+  title       = "%[1]s-related"
+  description = "related item"
+  source      = "terraform"
+}
+
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test description"
+  source      = "terraform"
+  category    = %[2]q
+  severity    = %[3]q
+  priority    = %[4]d
+  status      = %[5]q
+
+  operational_data {
+    key   = "/tf/key1"
+    value = %[6]q
+  }
+
+  related_ops_item_ids = [aws_ssm_ops_item.related.id]
+}
+`, rName, category, severity, priority, status, dataValue)
+}
+
+func testAccOpsItemConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test description"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsItemConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test description"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
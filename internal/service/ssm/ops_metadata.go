@@ -0,0 +1,249 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOpsMetadata() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOpsMetadataCreate,
+		Read:   resourceOpsMetadataRead,
+		Update: resourceOpsMetadataUpdate,
+		Delete: resourceOpsMetadataDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 4096),
+				},
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsMetadataCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	resourceID := d.Get("resource_id").(string)
+	input := &ssm.CreateOpsMetadataInput{
+		ResourceId: aws.String(resourceID),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Metadata = expandOpsMetadataValues(v.(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM OpsMetadata: %s", input)
+	output, err := conn.CreateOpsMetadata(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSM OpsMetadata (%s): %w", resourceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsMetadataArn))
+
+	return resourceOpsMetadataRead(d, meta)
+}
+
+func resourceOpsMetadataRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resourceID, metadata, err := FindOpsMetadataByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsMetadata (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM OpsMetadata (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", d.Id())
+
+	if err := d.Set("metadata", flattenOpsMetadataValues(metadata)); err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	d.Set("resource_id", resourceID)
+
+	taggingID, err := opsMetadataTaggingID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tags, err := ListTags(conn, taggingID, ssm.ResourceTypeForTaggingOpsMetadata)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SSM OpsMetadata (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOpsMetadataUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		oldMetadata := o.(map[string]interface{})
+		newMetadata := n.(map[string]interface{})
+
+		input := &ssm.UpdateOpsMetadataInput{
+			OpsMetadataArn: aws.String(d.Id()),
+		}
+
+		for k := range oldMetadata {
+			if _, ok := newMetadata[k]; !ok {
+				input.KeysToDelete = append(input.KeysToDelete, aws.String(k))
+			}
+		}
+
+		updatedMetadata := make(map[string]interface{})
+
+		for k, v := range newMetadata {
+			if old, ok := oldMetadata[k]; !ok || old.(string) != v.(string) {
+				updatedMetadata[k] = v
+			}
+		}
+
+		if len(updatedMetadata) > 0 {
+			input.MetadataToUpdate = expandOpsMetadataValues(updatedMetadata)
+		}
+
+		log.Printf("[DEBUG] Updating SSM OpsMetadata: %s", input)
+		_, err := conn.UpdateOpsMetadata(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SSM OpsMetadata (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		taggingID, err := opsMetadataTaggingID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := UpdateTags(conn, taggingID, ssm.ResourceTypeForTaggingOpsMetadata, o, n); err != nil {
+			return fmt.Errorf("error updating SSM OpsMetadata (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceOpsMetadataRead(d, meta)
+}
+
+func resourceOpsMetadataDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	log.Printf("[DEBUG] Deleting SSM OpsMetadata: %s", d.Id())
+	_, err := conn.DeleteOpsMetadata(&ssm.DeleteOpsMetadataInput{
+		OpsMetadataArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSM OpsMetadata (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// opsMetadataTaggingID returns the identifier used to tag an OpsMetadata object,
+// which is the portion of its ARN following "opsmetadata".
+func opsMetadataTaggingID(opsMetadataARN string) (string, error) {
+	parsedARN, err := arn.Parse(opsMetadataARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing SSM OpsMetadata ARN (%s): %w", opsMetadataARN, err)
+	}
+
+	return strings.TrimPrefix(parsedARN.Resource, "opsmetadata"), nil
+}
+
+func expandOpsMetadataValues(tfMap map[string]interface{}) map[string]*ssm.MetadataValue {
+	apiObjects := make(map[string]*ssm.MetadataValue, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects[k] = &ssm.MetadataValue{
+			Value: aws.String(v.(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenOpsMetadataValues(apiObjects map[string]*ssm.MetadataValue) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(apiObjects))
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[k] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
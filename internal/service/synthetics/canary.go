@@ -114,6 +114,11 @@ func ResourceCanary() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"environment_variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"memory_in_mb": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -385,7 +390,7 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting vpc config: %w", err)
 	}
 
-	if err := d.Set("run_config", flattenCanaryRunConfig(canary.RunConfig)); err != nil {
+	if err := d.Set("run_config", flattenCanaryRunConfig(canary.RunConfig, d.Get("run_config.0.environment_variables").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("error setting run config: %w", err)
 	}
 
@@ -703,10 +708,16 @@ func expandCanaryRunConfig(l []interface{}) *synthetics.CanaryRunConfigInput {
 		codeConfig.ActiveTracing = aws.Bool(v)
 	}
 
+	if v, ok := m["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		codeConfig.EnvironmentVariables = flex.ExpandStringMap(v)
+	}
+
 	return codeConfig
 }
 
-func flattenCanaryRunConfig(canaryCodeOut *synthetics.CanaryRunConfigOutput) []interface{} {
+// flattenCanaryRunConfig takes the environment variables from configuration as
+// the API does not return them.
+func flattenCanaryRunConfig(canaryCodeOut *synthetics.CanaryRunConfigOutput, envVars map[string]interface{}) []interface{} {
 	if canaryCodeOut == nil {
 		return []interface{}{}
 	}
@@ -717,6 +728,10 @@ func flattenCanaryRunConfig(canaryCodeOut *synthetics.CanaryRunConfigOutput) []i
 		"active_tracing":     aws.BoolValue(canaryCodeOut.ActiveTracing),
 	}
 
+	if len(envVars) > 0 {
+		m["environment_variables"] = envVars
+	}
+
 	return []interface{}{m}
 }
 
@@ -0,0 +1,650 @@
+package transcribe
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCallAnalyticsCategory() *schema.Resource {
+	absoluteTimeRangeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"start_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+
+	relativeTimeRangeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"start_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceCallAnalyticsCategoryCreate,
+		Read:   resourceCallAnalyticsCategoryRead,
+		Update: resourceCallAnalyticsCategoryUpdate,
+		Delete: resourceCallAnalyticsCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"category_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 200),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z._-]+$`), "must contain only alphanumeric characters, periods, underscores and hyphens"),
+				),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interruption_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema,
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"participant_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+									},
+									"relative_time_range": relativeTimeRangeSchema,
+									"threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"non_talk_time_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema,
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"relative_time_range": relativeTimeRangeSchema,
+									"threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"sentiment_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema,
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"participant_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+									},
+									"relative_time_range": relativeTimeRangeSchema,
+									"sentiments": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(transcribeservice.SentimentValue_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"transcript_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema,
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"participant_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+									},
+									"relative_time_range": relativeTimeRangeSchema,
+									"targets": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"transcript_filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.TranscriptFilterType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCallAnalyticsCategoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	name := d.Get("category_name").(string)
+	input := &transcribeservice.CreateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+		Rules:        expandRules(d.Get("rule").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Call Analytics Category: %s", input)
+	_, err := conn.CreateCallAnalyticsCategory(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Transcribe Call Analytics Category (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceCallAnalyticsCategoryRead(d, meta)
+}
+
+func resourceCallAnalyticsCategoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	output, err := FindCallAnalyticsCategoryByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Call Analytics Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Transcribe Call Analytics Category (%s): %w", d.Id(), err)
+	}
+
+	d.Set("category_name", output.CategoryName)
+
+	if err := d.Set("rule", flattenRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCallAnalyticsCategoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	input := &transcribeservice.UpdateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+		Rules:        expandRules(d.Get("rule").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Transcribe Call Analytics Category: %s", input)
+	_, err := conn.UpdateCallAnalyticsCategory(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Transcribe Call Analytics Category (%s): %w", d.Id(), err)
+	}
+
+	return resourceCallAnalyticsCategoryRead(d, meta)
+}
+
+func resourceCallAnalyticsCategoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Call Analytics Category: %s", d.Id())
+	_, err := conn.DeleteCallAnalyticsCategory(&transcribeservice.DeleteCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Transcribe Call Analytics Category (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRules(tfList []interface{}) []*transcribeservice.Rule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*transcribeservice.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &transcribeservice.Rule{}
+
+		if v, ok := tfMap["interruption_filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.InterruptionFilter = expandInterruptionFilter(v)
+		}
+
+		if v, ok := tfMap["non_talk_time_filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.NonTalkTimeFilter = expandNonTalkTimeFilter(v)
+		}
+
+		if v, ok := tfMap["sentiment_filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.SentimentFilter = expandSentimentFilter(v)
+		}
+
+		if v, ok := tfMap["transcript_filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.TranscriptFilter = expandTranscriptFilter(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInterruptionFilter(tfList []interface{}) *transcribeservice.InterruptionFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.InterruptionFilter{}
+
+	if v, ok := tfMap["absolute_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AbsoluteTimeRange = expandAbsoluteTimeRange(v)
+	}
+
+	if v, ok := tfMap["negate"].(bool); ok {
+		apiObject.Negate = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+		apiObject.ParticipantRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["relative_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RelativeTimeRange = expandRelativeTimeRange(v)
+	}
+
+	if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+		apiObject.Threshold = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandNonTalkTimeFilter(tfList []interface{}) *transcribeservice.NonTalkTimeFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.NonTalkTimeFilter{}
+
+	if v, ok := tfMap["absolute_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AbsoluteTimeRange = expandAbsoluteTimeRange(v)
+	}
+
+	if v, ok := tfMap["negate"].(bool); ok {
+		apiObject.Negate = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["relative_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RelativeTimeRange = expandRelativeTimeRange(v)
+	}
+
+	if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+		apiObject.Threshold = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandSentimentFilter(tfList []interface{}) *transcribeservice.SentimentFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.SentimentFilter{}
+
+	if v, ok := tfMap["absolute_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AbsoluteTimeRange = expandAbsoluteTimeRange(v)
+	}
+
+	if v, ok := tfMap["negate"].(bool); ok {
+		apiObject.Negate = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+		apiObject.ParticipantRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["relative_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RelativeTimeRange = expandRelativeTimeRange(v)
+	}
+
+	if v, ok := tfMap["sentiments"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Sentiments = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTranscriptFilter(tfList []interface{}) *transcribeservice.TranscriptFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.TranscriptFilter{}
+
+	if v, ok := tfMap["absolute_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AbsoluteTimeRange = expandAbsoluteTimeRange(v)
+	}
+
+	if v, ok := tfMap["negate"].(bool); ok {
+		apiObject.Negate = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+		apiObject.ParticipantRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["relative_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RelativeTimeRange = expandRelativeTimeRange(v)
+	}
+
+	if v, ok := tfMap["targets"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Targets = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["transcript_filter_type"].(string); ok && v != "" {
+		apiObject.TranscriptFilterType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAbsoluteTimeRange(tfList []interface{}) *transcribeservice.AbsoluteTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.AbsoluteTimeRange{}
+
+	if v, ok := tfMap["end_time"].(int); ok && v != 0 {
+		apiObject.EndTime = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v != 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v != 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_time"].(int); ok && v != 0 {
+		apiObject.StartTime = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandRelativeTimeRange(tfList []interface{}) *transcribeservice.RelativeTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.RelativeTimeRange{}
+
+	if v, ok := tfMap["end_percentage"].(int); ok && v != 0 {
+		apiObject.EndPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v != 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v != 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_percentage"].(int); ok && v != 0 {
+		apiObject.StartPercentage = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRules(apiObjects []*transcribeservice.Rule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.InterruptionFilter; v != nil {
+			tfMap["interruption_filter"] = flattenInterruptionFilter(v)
+		}
+
+		if v := apiObject.NonTalkTimeFilter; v != nil {
+			tfMap["non_talk_time_filter"] = flattenNonTalkTimeFilter(v)
+		}
+
+		if v := apiObject.SentimentFilter; v != nil {
+			tfMap["sentiment_filter"] = flattenSentimentFilter(v)
+		}
+
+		if v := apiObject.TranscriptFilter; v != nil {
+			tfMap["transcript_filter"] = flattenTranscriptFilter(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenInterruptionFilter(apiObject *transcribeservice.InterruptionFilter) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"absolute_time_range": flattenAbsoluteTimeRange(apiObject.AbsoluteTimeRange),
+		"negate":              aws.BoolValue(apiObject.Negate),
+		"participant_role":    aws.StringValue(apiObject.ParticipantRole),
+		"relative_time_range": flattenRelativeTimeRange(apiObject.RelativeTimeRange),
+		"threshold":           aws.Int64Value(apiObject.Threshold),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNonTalkTimeFilter(apiObject *transcribeservice.NonTalkTimeFilter) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"absolute_time_range": flattenAbsoluteTimeRange(apiObject.AbsoluteTimeRange),
+		"negate":              aws.BoolValue(apiObject.Negate),
+		"relative_time_range": flattenRelativeTimeRange(apiObject.RelativeTimeRange),
+		"threshold":           aws.Int64Value(apiObject.Threshold),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSentimentFilter(apiObject *transcribeservice.SentimentFilter) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"absolute_time_range": flattenAbsoluteTimeRange(apiObject.AbsoluteTimeRange),
+		"negate":              aws.BoolValue(apiObject.Negate),
+		"participant_role":    aws.StringValue(apiObject.ParticipantRole),
+		"relative_time_range": flattenRelativeTimeRange(apiObject.RelativeTimeRange),
+		"sentiments":          aws.StringValueSlice(apiObject.Sentiments),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTranscriptFilter(apiObject *transcribeservice.TranscriptFilter) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"absolute_time_range":    flattenAbsoluteTimeRange(apiObject.AbsoluteTimeRange),
+		"negate":                 aws.BoolValue(apiObject.Negate),
+		"participant_role":       aws.StringValue(apiObject.ParticipantRole),
+		"relative_time_range":    flattenRelativeTimeRange(apiObject.RelativeTimeRange),
+		"targets":                aws.StringValueSlice(apiObject.Targets),
+		"transcript_filter_type": aws.StringValue(apiObject.TranscriptFilterType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAbsoluteTimeRange(apiObject *transcribeservice.AbsoluteTimeRange) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"end_time":   aws.Int64Value(apiObject.EndTime),
+		"first":      aws.Int64Value(apiObject.First),
+		"last":       aws.Int64Value(apiObject.Last),
+		"start_time": aws.Int64Value(apiObject.StartTime),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRelativeTimeRange(apiObject *transcribeservice.RelativeTimeRange) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"end_percentage":   aws.Int64Value(apiObject.EndPercentage),
+		"first":            aws.Int64Value(apiObject.First),
+		"last":             aws.Int64Value(apiObject.Last),
+		"start_percentage": aws.Int64Value(apiObject.StartPercentage),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,109 @@
+package transcribe
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCallAnalyticsCategoryByName(conn *transcribeservice.TranscribeService, name string) (*transcribeservice.CategoryProperties, error) {
+	input := &transcribeservice.GetCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+	}
+
+	output, err := conn.GetCallAnalyticsCategory(input)
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CategoryProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CategoryProperties, nil
+}
+
+func FindLanguageModelByName(conn *transcribeservice.TranscribeService, name string) (*transcribeservice.LanguageModel, error) {
+	input := &transcribeservice.DescribeLanguageModelInput{
+		ModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeLanguageModel(input)
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LanguageModel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LanguageModel, nil
+}
+
+func FindVocabularyByName(conn *transcribeservice.TranscribeService, name string) (*transcribeservice.GetVocabularyOutput, error) {
+	input := &transcribeservice.GetVocabularyInput{
+		VocabularyName: aws.String(name),
+	}
+
+	output, err := conn.GetVocabulary(input)
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVocabularyFilterByName(conn *transcribeservice.TranscribeService, name string) (*transcribeservice.GetVocabularyFilterOutput, error) {
+	input := &transcribeservice.GetVocabularyFilterInput{
+		VocabularyFilterName: aws.String(name),
+	}
+
+	output, err := conn.GetVocabularyFilter(input)
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
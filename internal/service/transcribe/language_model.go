@@ -0,0 +1,270 @@
+package transcribe
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLanguageModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLanguageModelCreate,
+		Read:   resourceLanguageModelRead,
+		Update: resourceLanguageModelUpdate,
+		Delete: resourceLanguageModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(600 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.BaseModelName_Values(), false),
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+						"tuning_data_s3_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+					},
+				},
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.CLMLanguageCode_Values(), false),
+			},
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 200),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z._-]+$`), "must contain only alphanumeric characters, periods, underscores and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLanguageModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("model_name").(string)
+	input := &transcribeservice.CreateLanguageModelInput{
+		BaseModelName:   aws.String(d.Get("base_model_name").(string)),
+		InputDataConfig: expandInputDataConfig(d.Get("input_data_config").([]interface{})),
+		LanguageCode:    aws.String(d.Get("language_code").(string)),
+		ModelName:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Language Model: %s", input)
+	_, err := tfresource.RetryWhen(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateLanguageModel(input)
+		},
+		isDataAccessRoleNotPropagatedError,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Transcribe Language Model (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitLanguageModelCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Transcribe Language Model (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLanguageModelRead(d, meta)
+}
+
+func resourceLanguageModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindLanguageModelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Language Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Transcribe Language Model (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "transcribe",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("language-model/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("base_model_name", output.BaseModelName)
+	d.Set("language_code", output.LanguageCode)
+	d.Set("model_name", output.ModelName)
+
+	if err := d.Set("input_data_config", flattenInputDataConfig(output.InputDataConfig)); err != nil {
+		return fmt.Errorf("error setting input_data_config: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Transcribe Language Model (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLanguageModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Transcribe Language Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceLanguageModelRead(d, meta)
+}
+
+func resourceLanguageModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Language Model: %s", d.Id())
+	_, err := conn.DeleteLanguageModel(&transcribeservice.DeleteLanguageModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Transcribe Language Model (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// isDataAccessRoleNotPropagatedError returns true if the error indicates that
+// Transcribe is not yet able to assume a newly created data access role.
+func isDataAccessRoleNotPropagatedError(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, transcribeservice.ErrCodeBadRequestException, "Make sure that you have read permission") {
+		return true, err
+	}
+
+	return false, err
+}
+
+func expandInputDataConfig(tfList []interface{}) *transcribeservice.InputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &transcribeservice.InputDataConfig{}
+
+	if v, ok := tfMap["data_access_role_arn"].(string); ok && v != "" {
+		apiObject.DataAccessRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	if v, ok := tfMap["tuning_data_s3_uri"].(string); ok && v != "" {
+		apiObject.TuningDataS3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenInputDataConfig(apiObject *transcribeservice.InputDataConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"data_access_role_arn": aws.StringValue(apiObject.DataAccessRoleArn),
+		"s3_uri":               aws.StringValue(apiObject.S3Uri),
+		"tuning_data_s3_uri":   aws.StringValue(apiObject.TuningDataS3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,179 @@
+package transcribe_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeLanguageModel_basic(t *testing.T) {
+	var languageModel transcribeservice.LanguageModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_language_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLanguageModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLanguageModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &languageModel),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "transcribe", fmt.Sprintf("language-model/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "base_model_name", transcribeservice.BaseModelNameNarrowBand),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_data_config.0.data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", transcribeservice.CLMLanguageCodeEnUs),
+					resource.TestCheckResourceAttr(resourceName, "model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeLanguageModel_disappears(t *testing.T) {
+	var languageModel transcribeservice.LanguageModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_language_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLanguageModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLanguageModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &languageModel),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceLanguageModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLanguageModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_language_model" {
+			continue
+		}
+
+		_, err := tftranscribe.FindLanguageModelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Language Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLanguageModelExists(n string, v *transcribeservice.LanguageModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Language Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindLanguageModelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLanguageModelConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "training/data.txt"
+  content = "Terraform makes it easy to provision Amazon Transcribe custom language models.\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "transcribe.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_transcribe_language_model" "test" {
+  model_name      = %[1]q
+  base_model_name = "NarrowBand"
+  language_code   = "en-US"
+
+  input_data_config {
+    data_access_role_arn = aws_iam_role.test.arn
+    s3_uri               = "s3://${aws_s3_bucket.test.id}/training/"
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_bucket_object.test]
+}
+`, rName)
+}
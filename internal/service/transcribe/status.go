@@ -0,0 +1,40 @@
+package transcribe
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusLanguageModel(conn *transcribeservice.TranscribeService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLanguageModelByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ModelStatus), nil
+	}
+}
+
+func statusVocabulary(conn *transcribeservice.TranscribeService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVocabularyByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.VocabularyState), nil
+	}
+}
@@ -0,0 +1,213 @@
+package transcribe
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVocabularyFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVocabularyFilterCreate,
+		Read:   resourceVocabularyFilterRead,
+		Update: resourceVocabularyFilterUpdate,
+		Delete: resourceVocabularyFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"download_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.LanguageCode_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vocabulary_filter_file_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+				ExactlyOneOf: []string{"vocabulary_filter_file_uri", "words"},
+			},
+			"vocabulary_filter_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 200),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z._-]+$`), "must contain only alphanumeric characters, periods, underscores and hyphens"),
+				),
+			},
+			"words": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"vocabulary_filter_file_uri", "words"},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVocabularyFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("vocabulary_filter_name").(string)
+	input := &transcribeservice.CreateVocabularyFilterInput{
+		LanguageCode:         aws.String(d.Get("language_code").(string)),
+		VocabularyFilterName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("vocabulary_filter_file_uri"); ok {
+		input.VocabularyFilterFileUri = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("words"); ok && len(v.([]interface{})) > 0 {
+		input.Words = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Vocabulary Filter: %s", input)
+	_, err := conn.CreateVocabularyFilter(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Transcribe Vocabulary Filter (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceVocabularyFilterRead(d, meta)
+}
+
+func resourceVocabularyFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindVocabularyFilterByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Vocabulary Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Transcribe Vocabulary Filter (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "transcribe",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("vocabulary-filter/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("download_uri", output.DownloadUri)
+	d.Set("language_code", output.LanguageCode)
+	d.Set("vocabulary_filter_name", output.VocabularyFilterName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Transcribe Vocabulary Filter (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVocabularyFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &transcribeservice.UpdateVocabularyFilterInput{
+			VocabularyFilterName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("vocabulary_filter_file_uri"); ok {
+			input.VocabularyFilterFileUri = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("words"); ok && len(v.([]interface{})) > 0 {
+			input.Words = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Transcribe Vocabulary Filter: %s", input)
+		_, err := conn.UpdateVocabularyFilter(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Transcribe Vocabulary Filter (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Transcribe Vocabulary Filter (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceVocabularyFilterRead(d, meta)
+}
+
+func resourceVocabularyFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Vocabulary Filter: %s", d.Id())
+	_, err := conn.DeleteVocabularyFilter(&transcribeservice.DeleteVocabularyFilterInput{
+		VocabularyFilterName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Transcribe Vocabulary Filter (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package transcribe_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeVocabularyFilter_basic(t *testing.T) {
+	var vocabularyFilter transcribeservice.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterConfig(rName, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &vocabularyFilter),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "transcribe", fmt.Sprintf("vocabulary-filter/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_filter_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "words.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "words.0", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "words.1", "bar"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"words"},
+			},
+			{
+				Config: testAccVocabularyFilterConfig(rName, "baz", "qux"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttr(resourceName, "words.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "words.0", "baz"),
+					resource.TestCheckResourceAttr(resourceName, "words.1", "qux"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabularyFilter_tags(t *testing.T) {
+	var vocabularyFilter transcribeservice.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"words"},
+			},
+			{
+				Config: testAccVocabularyFilterConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVocabularyFilterConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabularyFilter_disappears(t *testing.T) {
+	var vocabularyFilter transcribeservice.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterConfig(rName, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &vocabularyFilter),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceVocabularyFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVocabularyFilterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_vocabulary_filter" {
+			continue
+		}
+
+		_, err := tftranscribe.FindVocabularyFilterByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Vocabulary Filter %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVocabularyFilterExists(n string, v *transcribeservice.GetVocabularyFilterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Vocabulary Filter ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindVocabularyFilterByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVocabularyFilterConfig(rName, word1, word2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name = %[1]q
+  language_code          = "en-US"
+  words                  = [%[2]q, %[3]q]
+}
+`, rName, word1, word2)
+}
+
+func testAccVocabularyFilterConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name = %[1]q
+  language_code          = "en-US"
+  words                  = ["foo", "bar"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccVocabularyFilterConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name = %[1]q
+  language_code          = "en-US"
+  words                  = ["foo", "bar"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
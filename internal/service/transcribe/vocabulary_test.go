@@ -0,0 +1,260 @@
+package transcribe_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeVocabulary_basic(t *testing.T) {
+	var vocabulary transcribeservice.GetVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyConfig(rName, "Los-Angeles", "New-York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "transcribe", fmt.Sprintf("vocabulary/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "phrases.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "phrases.0", "Los-Angeles"),
+					resource.TestCheckResourceAttr(resourceName, "phrases.1", "New-York"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"phrases"},
+			},
+			{
+				Config: testAccVocabularyConfig(rName, "Chicago", "Seattle"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					resource.TestCheckResourceAttr(resourceName, "phrases.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "phrases.0", "Chicago"),
+					resource.TestCheckResourceAttr(resourceName, "phrases.1", "Seattle"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabulary_fileURI(t *testing.T) {
+	var vocabulary transcribeservice.GetVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyConfigFileURI(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					resource.TestCheckResourceAttr(resourceName, "phrases.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "vocabulary_file_uri"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabulary_tags(t *testing.T) {
+	var vocabulary transcribeservice.GetVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"phrases"},
+			},
+			{
+				Config: testAccVocabularyConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVocabularyConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabulary_disappears(t *testing.T) {
+	var vocabulary transcribeservice.GetVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(transcribeservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyConfig(rName, "Los-Angeles", "New-York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(resourceName, &vocabulary),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceVocabulary(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVocabularyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_vocabulary" {
+			continue
+		}
+
+		_, err := tftranscribe.FindVocabularyByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Vocabulary %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVocabularyExists(n string, v *transcribeservice.GetVocabularyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Vocabulary ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindVocabularyByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVocabularyConfig(rName, phrase1, phrase2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary" "test" {
+  vocabulary_name = %[1]q
+  language_code   = "en-US"
+  phrases         = [%[2]q, %[3]q]
+}
+`, rName, phrase1, phrase2)
+}
+
+func testAccVocabularyConfigFileURI(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "vocabulary.txt"
+  content = "Los-Angeles\nNew-York\n"
+}
+
+resource "aws_transcribe_vocabulary" "test" {
+  vocabulary_name     = %[1]q
+  language_code       = "en-US"
+  vocabulary_file_uri = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+}
+`, rName)
+}
+
+func testAccVocabularyConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary" "test" {
+  vocabulary_name = %[1]q
+  language_code   = "en-US"
+  phrases         = ["Los-Angeles", "New-York"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccVocabularyConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary" "test" {
+  vocabulary_name = %[1]q
+  language_code   = "en-US"
+  phrases         = ["Los-Angeles", "New-York"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,85 @@
+package transcribe
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitLanguageModelCreated(conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.LanguageModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transcribeservice.ModelStatusInProgress},
+		Target:  []string{transcribeservice.ModelStatusCompleted},
+		Refresh: statusLanguageModel(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*transcribeservice.LanguageModel); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVocabularyCreated(conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transcribeservice.VocabularyStatePending},
+		Target:  []string{transcribeservice.VocabularyStateReady},
+		Refresh: statusVocabulary(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*transcribeservice.GetVocabularyOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVocabularyUpdated(conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transcribeservice.VocabularyStatePending},
+		Target:  []string{transcribeservice.VocabularyStateReady},
+		Refresh: statusVocabulary(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*transcribeservice.GetVocabularyOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVocabularyDeleted(conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transcribeservice.VocabularyStatePending, transcribeservice.VocabularyStateReady, transcribeservice.VocabularyStateFailed},
+		Target:  []string{},
+		Refresh: statusVocabulary(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*transcribeservice.GetVocabularyOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
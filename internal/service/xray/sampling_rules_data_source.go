@@ -0,0 +1,148 @@
+package xray
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceSamplingRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSamplingRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"sampling_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"fixed_rate": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reservoir_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSamplingRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	var rules []*xray.SamplingRule
+
+	err := conn.GetSamplingRulesPages(&xray.GetSamplingRulesInput{}, func(page *xray.GetSamplingRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, record := range page.SamplingRuleRecords {
+			if record == nil || record.SamplingRule == nil {
+				continue
+			}
+
+			rules = append(rules, record.SamplingRule)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading X-Ray Sampling Rules: %w", err)
+	}
+
+	// X-Ray evaluates sampling rules in ascending priority order.
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+	})
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("sampling_rules", flattenSamplingRules(rules)); err != nil {
+		return fmt.Errorf("error setting sampling_rules: %w", err)
+	}
+
+	return nil
+}
+
+func flattenSamplingRules(apiObjects []*xray.SamplingRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":            aws.StringValue(apiObject.RuleARN),
+			"attributes":     aws.StringValueMap(apiObject.Attributes),
+			"fixed_rate":     aws.Float64Value(apiObject.FixedRate),
+			"host":           aws.StringValue(apiObject.Host),
+			"http_method":    aws.StringValue(apiObject.HTTPMethod),
+			"priority":       aws.Int64Value(apiObject.Priority),
+			"reservoir_size": aws.Int64Value(apiObject.ReservoirSize),
+			"resource_arn":   aws.StringValue(apiObject.ResourceARN),
+			"rule_name":      aws.StringValue(apiObject.RuleName),
+			"service_name":   aws.StringValue(apiObject.ServiceName),
+			"service_type":   aws.StringValue(apiObject.ServiceType),
+			"url_path":       aws.StringValue(apiObject.URLPath),
+			"version":        aws.Int64Value(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,70 @@
+package xray_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/xray"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccXRaySamplingRulesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_xray_sampling_rules.test"
+	resourceName := "aws_xray_sampling_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(xray.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, xray.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSamplingRulesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					// The account always has the "Default" rule with the lowest priority.
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "sampling_rules.*", map[string]string{
+						"rule_name": "Default",
+						"priority":  "10000",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "sampling_rules.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "sampling_rules.*", map[string]string{
+						"rule_name":        rName,
+						"priority":         "5",
+						"fixed_rate":       "0.3",
+						"reservoir_size":   "5",
+						"attributes.%":     "1",
+						"attributes.Hello": "World",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSamplingRulesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test" {
+  rule_name      = %[1]q
+  priority       = 5
+  reservoir_size = 5
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+
+  attributes = {
+    Hello = "World"
+  }
+}
+
+data "aws_xray_sampling_rules" "test" {
+  depends_on = [aws_xray_sampling_rule.test]
+}
+`, rName)
+}
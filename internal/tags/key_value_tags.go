@@ -28,6 +28,31 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+
+	// NoRefresh and NoRefreshServices disable reading tags during refresh,
+	// either for all services or for the listed service endpoint IDs.
+	NoRefresh         bool
+	NoRefreshServices []string
+}
+
+// SkipRefresh returns whether resource tags should not be read for a service.
+// Resources honoring this leave tags and tags_all as last recorded in state.
+func (config *IgnoreConfig) SkipRefresh(serviceName string) bool {
+	if config == nil {
+		return false
+	}
+
+	if config.NoRefresh {
+		return true
+	}
+
+	for _, v := range config.NoRefreshServices {
+		if v == serviceName {
+			return true
+		}
+	}
+
+	return false
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
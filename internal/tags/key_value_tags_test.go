@@ -544,6 +544,62 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestIgnoreConfigSkipRefresh(t *testing.T) {
+	testCases := []struct {
+		name         string
+		ignoreConfig *IgnoreConfig
+		service      string
+		want         bool
+	}{
+		{
+			name:         "no config",
+			ignoreConfig: nil,
+			service:      "elasticache",
+			want:         false,
+		},
+		{
+			name:         "empty config",
+			ignoreConfig: &IgnoreConfig{},
+			service:      "elasticache",
+			want:         false,
+		},
+		{
+			name: "all services",
+			ignoreConfig: &IgnoreConfig{
+				NoRefresh: true,
+			},
+			service: "elasticache",
+			want:    true,
+		},
+		{
+			name: "matching service",
+			ignoreConfig: &IgnoreConfig{
+				NoRefreshServices: []string{"redshift", "elasticache"},
+			},
+			service: "elasticache",
+			want:    true,
+		},
+		{
+			name: "other service",
+			ignoreConfig: &IgnoreConfig{
+				NoRefreshServices: []string{"redshift"},
+			},
+			service: "elasticache",
+			want:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.ignoreConfig.SkipRefresh(testCase.service)
+
+			if got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	testCases := []struct {
 		name string